@@ -0,0 +1,68 @@
+package huaweicloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/common/tags"
+)
+
+// TagsSchema returns the common `tags` schema shared by resources that
+// support HuaweiCloud's resource-tag service.
+func TagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// ExpandResourceTags turns a `tags` map from the schema into the
+// []tags.ResourceTag shape the tag service API expects.
+func ExpandResourceTags(tagmap map[string]interface{}) []tags.ResourceTag {
+	taglist := make([]tags.ResourceTag, 0, len(tagmap))
+	for k, v := range tagmap {
+		tag := tags.ResourceTag{
+			Key:   k,
+			Value: v.(string),
+		}
+		taglist = append(taglist, tag)
+	}
+
+	return taglist
+}
+
+// TagsToMap turns a []tags.ResourceTag response from the tag service API
+// back into a map suitable for d.Set("tags", ...).
+func TagsToMap(taglist []tags.ResourceTag) map[string]string {
+	tagmap := make(map[string]string)
+	for _, tag := range taglist {
+		tagmap[tag.Key] = tag.Value
+	}
+
+	return tagmap
+}
+
+// UpdateResourceTags replaces the full tag set on resourceType/resourceID
+// with whatever is currently in d.Get("tags"). It is meant to be called
+// from a resource's Create (after the resource exists) and Update (when
+// d.HasChange("tags")).
+func UpdateResourceTags(client *golangsdk.ServiceClient, d *schema.ResourceData, resourceType, resourceID string) error {
+	oldRaw, newRaw := d.GetChange("tags")
+	oldTags := ExpandResourceTags(oldRaw.(map[string]interface{}))
+	newTags := ExpandResourceTags(newRaw.(map[string]interface{}))
+
+	if len(oldTags) > 0 {
+		if err := tags.DeleteTags(client, resourceType, resourceID, oldTags); err != nil {
+			return err
+		}
+	}
+
+	if len(newTags) > 0 {
+		if err := tags.CreateTags(client, resourceType, resourceID, newTags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,11 +3,15 @@ package huaweicloud
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -46,6 +50,10 @@ type Config struct {
 	Token               string
 	Username            string
 	UserID              string
+	OAuth2Token         string
+	OAuth2ClientID      string
+	OAuth2ClientSecret  string
+	OAuth2Scope         string
 	AgencyName          string
 	AgencyDomainName    string
 	DelegatedProject    string
@@ -54,10 +62,30 @@ type Config struct {
 	TerraformVersion    string
 	RegionClient        bool
 	EnterpriseProjectID string
+	UseHcsSDK           bool
+	RfsEndpoint         string
+	RfsProjectID        string
+	AssumeAgencies      []AssumeAgency
+	AssumedDomainID     string
+	AssumedProjectID    string
+	Regions             []RegionAlias
+	CustomDNSLines      []string
 
 	HwClient *golangsdk.ProviderClient
 	s3sess   *session.Session
 
+	// PrimaryHwClient is the client authenticated from the primary
+	// credentials, captured before the first assume_agency hop. Refreshing
+	// the chain always re-assumes from here, never from the (possibly
+	// expired) previously-assumed HwClient.
+	PrimaryHwClient *golangsdk.ProviderClient
+
+	// assumeAgencyRefreshStop stops the proactive assume_agency refresh
+	// goroutine started by startAssumeAgencyAutoRefresh, so a later refresh
+	// (proactive or 401-triggered) can cancel a still-pending one instead of
+	// letting two timers race to re-assume the chain.
+	assumeAgencyRefreshStop chan struct{}
+
 	DomainClient *golangsdk.ProviderClient
 
 	// RegionProjectIDMap is a map which stores the region-projectId pairs,
@@ -79,6 +107,9 @@ func (c *Config) LoadAndValidate() error {
 	if c.Token != "" {
 		err = buildClientByToken(c)
 
+	} else if c.OAuth2Token != "" || c.OAuth2ClientID != "" {
+		err = buildClientByOAuth2(c)
+
 	} else if c.AccessKey != "" && c.SecretKey != "" {
 		err = buildClientByAKSK(c)
 
@@ -94,6 +125,12 @@ func (c *Config) LoadAndValidate() error {
 		return err
 	}
 
+	if len(c.AssumeAgencies) > 0 {
+		if err := c.assumeAgencyChain(); err != nil {
+			return err
+		}
+	}
+
 	return c.newS3Session(logging.IsDebugOrHigher())
 }
 
@@ -478,9 +515,12 @@ func (c *Config) newServiceClientByName(client *golangsdk.ProviderClient, catalo
 	return sc, nil
 }
 
-// loadUserProjects will query the region-projectId pair and store it into RegionProjectIDMap
-func (c *Config) loadUserProjects(client *golangsdk.ProviderClient, region string) error {
-
+// fetchUserProjects queries the projects belonging to region over the
+// network, without touching RegionProjectIDMap. It does not need c.RPLock
+// held, since it doesn't read or write the shared map; callers that do
+// write its result into RegionProjectIDMap are responsible for locking
+// around that write themselves.
+func (c *Config) fetchUserProjects(client *golangsdk.ProviderClient, region string) ([]projects.Project, error) {
 	log.Printf("Load projectID for region: %s", region)
 	domainID := client.DomainID
 	opts := projects.ListOpts{
@@ -492,16 +532,28 @@ func (c *Config) loadUserProjects(client *golangsdk.ProviderClient, region strin
 	sc.ProviderClient = client
 	allPages, err := projects.List(sc, &opts).AllPages()
 	if err != nil {
-		return fmt.Errorf("List projects failed, err=%s", err)
+		return nil, fmt.Errorf("List projects failed, err=%s", err)
 	}
 
 	all, err := projects.ExtractProjects(allPages)
 	if err != nil {
-		return fmt.Errorf("Extract projects failed, err=%s", err)
+		return nil, fmt.Errorf("Extract projects failed, err=%s", err)
 	}
 
 	if len(all) == 0 {
-		return fmt.Errorf("Wrong name or no access to the region: %s", region)
+		return nil, fmt.Errorf("Wrong name or no access to the region: %s", region)
+	}
+
+	return all, nil
+}
+
+// loadUserProjects will query the region-projectId pair and store it into
+// RegionProjectIDMap. Unlike fetchUserProjects, it writes into the shared
+// map, so callers must hold c.RPLock for the duration of the call.
+func (c *Config) loadUserProjects(client *golangsdk.ProviderClient, region string) error {
+	all, err := c.fetchUserProjects(client, region)
+	if err != nil {
+		return err
 	}
 
 	for _, item := range all {
@@ -752,3 +804,467 @@ func (c *Config) orchestrationV1Client(region string) (*golangsdk.ServiceClient,
 func (c *Config) mlsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return c.NewServiceClient("mls", region)
 }
+
+// ********** client for Global Accelerator **********
+// GA is a global service that is not bound to any particular region, so
+// instead of going through the region-scoped NewServiceClient/ServiceCatalog
+// plumbing, it is reached through a dedicated endpoint built directly from
+// the `cloud` provider attribute.
+func (c *Config) GaV1Client() (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[c.Region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, c.Region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[c.Region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = c.Region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://ga.%s/", c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v1/"
+
+	return sc, nil
+}
+
+// ********** OAuth2 bearer-token authentication **********
+//
+// oauth2RefreshMargin is how long before the token's reported expiry it is
+// proactively refreshed, so in-flight requests never race a token that just
+// went stale.
+const oauth2RefreshMargin = 60 * time.Second
+
+// oauth2RefreshRetryDelay is how long startAutoRefresh waits before trying
+// again after a failed refresh, so a transient error doesn't spin the loop
+// tight against an expiry that's already passed.
+const oauth2RefreshRetryDelay = 10 * time.Second
+
+// oauth2TokenResponse is the body returned by the OAuth2 token endpoint
+// (the equivalent of ShowOauth2Token).
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// oauth2TokenSource holds the current bearer token and, when client
+// credentials are configured, keeps it refreshed in the background ahead
+// of its expiry.
+type oauth2TokenSource struct {
+	config *Config
+
+	mut       sync.RWMutex
+	token     string
+	expiresAt time.Time
+
+	stopRefresh chan struct{}
+}
+
+// fetch exchanges oauth2_client_id/oauth2_client_secret for a bearer token.
+// When no client credentials are configured, the static oauth2_token is
+// used as-is and is never refreshed.
+func (s *oauth2TokenSource) fetch() (string, time.Duration, error) {
+	c := s.config
+	if c.OAuth2ClientID == "" {
+		return c.OAuth2Token, 0, nil
+	}
+
+	values := url.Values{}
+	values.Set("grant_type", "client_credentials")
+	values.Set("client_id", c.OAuth2ClientID)
+	values.Set("client_secret", c.OAuth2ClientSecret)
+	if c.OAuth2Scope != "" {
+		values.Set("scope", c.OAuth2Scope)
+	}
+
+	endpoint := strings.TrimRight(c.IdentityEndpoint, "/") + "/oauth2/token"
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", httpclient.TerraformUserAgent(c.TerraformVersion))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("Error requesting OAuth2 token: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("Error requesting OAuth2 token: status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tokenResp oauth2TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", 0, fmt.Errorf("Error parsing OAuth2 token response: %s", err)
+	}
+
+	return tokenResp.AccessToken, time.Duration(tokenResp.ExpiresIn) * time.Second, nil
+}
+
+func (s *oauth2TokenSource) refresh() error {
+	token, ttl, err := s.fetch()
+	if err != nil {
+		return err
+	}
+
+	s.mut.Lock()
+	s.token = token
+	if ttl > 0 {
+		s.expiresAt = time.Now().Add(ttl)
+	} else {
+		s.expiresAt = time.Time{}
+	}
+	s.mut.Unlock()
+	return nil
+}
+
+// startAutoRefresh refreshes the token shortly before it expires. It is a
+// no-op when the token has no expiry, i.e. a static oauth2_token with no
+// client credentials to refresh it with.
+func (s *oauth2TokenSource) startAutoRefresh() {
+	s.mut.RLock()
+	expiresAt := s.expiresAt
+	s.mut.RUnlock()
+	if expiresAt.IsZero() {
+		return
+	}
+
+	s.stopRefresh = make(chan struct{})
+	go func() {
+		for {
+			s.mut.RLock()
+			wait := time.Until(s.expiresAt) - oauth2RefreshMargin
+			s.mut.RUnlock()
+			if wait < 0 {
+				wait = 0
+			}
+
+			select {
+			case <-time.After(wait):
+				if err := s.refresh(); err != nil {
+					log.Printf("[WARN] Error refreshing OAuth2 access token, will retry: %s", err)
+					select {
+					case <-time.After(oauth2RefreshRetryDelay):
+					case <-s.stopRefresh:
+						return
+					}
+				}
+			case <-s.stopRefresh:
+				return
+			}
+		}
+	}()
+}
+
+func (s *oauth2TokenSource) Token() string {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.token
+}
+
+// oauth2RoundTripper injects the current bearer token as an Authorization
+// header on every outgoing request, ahead of the underlying transport.
+type oauth2RoundTripper struct {
+	Rt     http.RoundTripper
+	Source *oauth2TokenSource
+}
+
+func (rt *oauth2RoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+rt.Source.Token())
+	return rt.Rt.RoundTrip(req)
+}
+
+// buildClientByOAuth2 authenticates with an OAuth2 bearer token instead of
+// the IAM v3 token/AKSK/password flows: it bypasses huaweisdk.Authenticate
+// entirely and injects "Authorization: Bearer <token>" on every request,
+// refreshing the token in the background ahead of its expiry.
+func buildClientByOAuth2(c *Config) error {
+	client, err := huaweisdk.NewClient(c.IdentityEndpoint)
+	if err != nil {
+		return err
+	}
+	client.UserAgent.Prepend(httpclient.TerraformUserAgent(c.TerraformVersion))
+
+	// huaweisdk.Authenticate() is what normally sets these from the chosen
+	// AuthOptionsProvider; OAuth2 bypasses it entirely, so they have to be
+	// populated here the same way buildClientByToken/AKSK/Password do, or
+	// fetchUserProjects/newServiceClientByName can't resolve a project ID.
+	client.DomainID = c.DomainID
+	client.ProjectID = c.TenantID
+
+	tlsConfig, err := generateTLSConfig(c)
+	if err != nil {
+		return err
+	}
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}
+
+	source := &oauth2TokenSource{config: c}
+	if err := source.refresh(); err != nil {
+		return fmt.Errorf("Error obtaining OAuth2 access token: %s", err)
+	}
+	source.startAutoRefresh()
+
+	client.HTTPClient = http.Client{
+		Transport: &LogRoundTripper{
+			Rt: &oauth2RoundTripper{
+				Rt:     transport,
+				Source: source,
+			},
+			OsDebug:    logging.IsDebugOrHigher(),
+			MaxRetries: c.MaxRetries,
+		},
+	}
+
+	if err := validateOAuth2Service(c, client); err != nil {
+		return err
+	}
+
+	c.HwClient = client
+	c.DomainClient = client
+	return nil
+}
+
+// validateOAuth2Service probes the IAM endpoint with the freshly-obtained
+// token so an invalid token or unreachable OAuth2 provider fails fast during
+// provider setup instead of on the first resource apply.
+func validateOAuth2Service(c *Config, client *golangsdk.ProviderClient) error {
+	sc := new(golangsdk.ServiceClient)
+	sc.Endpoint = c.IdentityEndpoint + "/"
+	sc.ProviderClient = client
+
+	if _, err := projects.List(sc, &projects.ListOpts{}).AllPages(); err != nil {
+		return fmt.Errorf("Error validating OAuth2 access token: %s", err)
+	}
+	return nil
+}
+
+// ********** client for VPN **********
+// VpnV5Client reaches the native (non-Neutron) VPN v5 API, which is
+// region-scoped like most services but isn't registered in
+// allServiceCatalog yet, so the endpoint is built directly here the same
+// way GaV1Client does for Global Accelerator.
+func (c *Config) VpnV5Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://vpn.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v5/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for CSE **********
+// CseV2Client reaches the Cloud Service Engine (microservice engine) API,
+// which like VpnV5Client isn't registered in allServiceCatalog yet.
+func (c *Config) CseV2Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://cse.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v2/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for CloudPipeline **********
+// CloudPipelineV2Client reaches the CloudPipeline CI/CD API, which like
+// CseV2Client isn't registered in allServiceCatalog yet.
+func (c *Config) CloudPipelineV2Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://cloudpipeline.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v2/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for CloudPond **********
+// CloudPondV1Client reaches the CloudPond hybrid/edge API, which like
+// CseV2Client isn't registered in allServiceCatalog yet.
+func (c *Config) CloudPondV1Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://cloudpond.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v1/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for CodeCheck **********
+// CodeCheckV1Client reaches the CodeCheck static-analysis API, which like
+// CseV2Client isn't registered in allServiceCatalog yet.
+func (c *Config) CodeCheckV1Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://codecheck.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v1/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for RFS **********
+// RfsV1Client reaches the Resource Formation Service API, which like
+// CseV2Client isn't registered in allServiceCatalog yet. RfsEndpoint and
+// RfsProjectID let callers point at a dedicated RFS deployment instead of
+// the region-derived default, since early RFS rollouts aren't available
+// in every region.
+func (c *Config) RfsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID := c.RfsProjectID
+	if projectID == "" {
+		var ok bool
+		projectID, ok = c.RegionProjectIDMap[region]
+		if !ok {
+			if err := c.loadUserProjects(c.HwClient, region); err != nil {
+				return nil, err
+			}
+			projectID = c.RegionProjectIDMap[region]
+		}
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	if c.RfsEndpoint != "" {
+		sc.Endpoint = c.RfsEndpoint
+	} else {
+		sc.Endpoint = fmt.Sprintf("https://rfs.%s.%s/", region, c.Cloud)
+	}
+	sc.ResourceBase = sc.Endpoint + "v1/" + projectID + "/"
+
+	return sc, nil
+}
+
+// ********** client for EPS **********
+// EpsV1Client reaches the Enterprise Project Management Service API, which
+// like CseV2Client isn't registered in allServiceCatalog yet.
+func (c *Config) EpsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	c.RPLock.Lock()
+	defer c.RPLock.Unlock()
+	projectID, ok := c.RegionProjectIDMap[region]
+	if !ok {
+		if err := c.loadUserProjects(c.HwClient, region); err != nil {
+			return nil, err
+		}
+		projectID = c.RegionProjectIDMap[region]
+	}
+
+	sc := new(golangsdk.ServiceClient)
+
+	clone := new(golangsdk.ProviderClient)
+	*clone = *c.HwClient
+	clone.ProjectID = projectID
+	clone.AKSKAuthOptions.ProjectId = projectID
+	clone.AKSKAuthOptions.Region = region
+	sc.ProviderClient = clone
+
+	sc.Endpoint = fmt.Sprintf("https://eps.%s.%s/", region, c.Cloud)
+	sc.ResourceBase = sc.Endpoint + "v1.0/" + projectID + "/"
+
+	return sc, nil
+}
@@ -0,0 +1,194 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// assumeAgencyRefreshMargin is how long before the chain's shortest hop
+// expires it is proactively re-assumed, mirroring oauth2RefreshMargin in
+// config.go.
+const assumeAgencyRefreshMargin = 60 * time.Second
+
+// assumeAgencyRefreshRetryDelay is how long the proactive refresh loop
+// waits before trying again after a failed chain re-assumption, mirroring
+// oauth2RefreshRetryDelay in config.go.
+const assumeAgencyRefreshRetryDelay = 10 * time.Second
+
+// AssumeAgency is one hop of an assume_agency chain: the provider
+// authenticates with the primary credentials, then successively assumes
+// each agency in order, using the previous hop's AK/SK and domain ID as the
+// delegating credentials for the next.
+type AssumeAgency struct {
+	AgencyName      string
+	DomainName      string
+	DurationSeconds int
+}
+
+// assumeAgencyChain re-authenticates through each hop of c.AssumeAgencies in
+// turn, starting from c.PrimaryHwClient (the primary-credential client,
+// captured on first use) rather than the possibly already-assumed
+// c.HwClient, so a refresh re-derives every hop's temporary credentials
+// from scratch instead of compounding off an expired assumed identity.
+func (c *Config) assumeAgencyChain() error {
+	if c.PrimaryHwClient == nil {
+		c.PrimaryHwClient = c.HwClient
+	}
+	client := c.PrimaryHwClient
+
+	for _, agency := range c.AssumeAgencies {
+		ao := golangsdk.AKSKAuthOptions{
+			IdentityEndpoint: c.IdentityEndpoint,
+			AccessKey:        client.AKSKAuthOptions.AccessKey,
+			SecretKey:        client.AKSKAuthOptions.SecretKey,
+			DomainID:         client.DomainID,
+			Domain:           agency.DomainName,
+			AgencyName:       agency.AgencyName,
+			AgencyDomainName: agency.DomainName,
+		}
+
+		next, err := genClient(c, ao)
+		if err != nil {
+			return fmt.Errorf("Error assuming agency %q in domain %q: %s", agency.AgencyName, agency.DomainName, err)
+		}
+
+		log.Printf("[DEBUG] assumed agency %q in domain %q, new project id: %s", agency.AgencyName, agency.DomainName, next.ProjectID)
+		client = next
+	}
+
+	c.HwClient = client
+	c.AssumedDomainID = client.DomainID
+	c.AssumedProjectID = client.ProjectID
+
+	c.HwClient.HTTPClient.Transport = &agencyRefresherRoundTripper{
+		Rt:     c.HwClient.HTTPClient.Transport,
+		Config: c,
+	}
+
+	c.startAssumeAgencyAutoRefresh()
+
+	return nil
+}
+
+// startAssumeAgencyAutoRefresh proactively re-assumes the chain shortly
+// before its shortest-lived hop expires, instead of relying solely on
+// agencyRefresherRoundTripper's reactive 401 retry. It is a no-op if no hop
+// configured a duration_seconds. Each successful assumeAgencyChain call
+// re-arms this, so the chain keeps renewing itself indefinitely; any
+// previously scheduled refresh is stopped first so refreshes (proactive or
+// 401-triggered) never stack up multiple timers.
+func (c *Config) startAssumeAgencyAutoRefresh() {
+	if c.assumeAgencyRefreshStop != nil {
+		close(c.assumeAgencyRefreshStop)
+	}
+
+	minDuration := minAssumeAgencyDuration(c.AssumeAgencies)
+	if minDuration <= 0 {
+		c.assumeAgencyRefreshStop = nil
+		return
+	}
+
+	stop := make(chan struct{})
+	c.assumeAgencyRefreshStop = stop
+
+	wait := time.Duration(minDuration)*time.Second - assumeAgencyRefreshMargin
+	if wait < 0 {
+		wait = 0
+	}
+
+	go func() {
+		select {
+		case <-time.After(wait):
+		case <-stop:
+			return
+		}
+
+		for {
+			if err := c.assumeAgencyChain(); err != nil {
+				log.Printf("[WARN] Error proactively refreshing assume_agency chain, will retry: %s", err)
+				select {
+				case <-time.After(assumeAgencyRefreshRetryDelay):
+					continue
+				case <-stop:
+					return
+				}
+			}
+			// assumeAgencyChain re-arms the next proactive refresh itself.
+			return
+		}
+	}()
+}
+
+// minAssumeAgencyDuration is the shortest duration_seconds across every hop
+// of the chain, since an earlier hop's credentials expiring invalidates
+// every hop assumed on top of them regardless of their own duration.
+// Hops with no duration configured (0) are ignored.
+func minAssumeAgencyDuration(agencies []AssumeAgency) int {
+	min := 0
+	for _, agency := range agencies {
+		if agency.DurationSeconds <= 0 {
+			continue
+		}
+		if min == 0 || agency.DurationSeconds < min {
+			min = agency.DurationSeconds
+		}
+	}
+	return min
+}
+
+// agencyRefresherRoundTripper re-runs the assume_agency chain and retries
+// the request once when a call comes back 401, so temporary AK/SK/tokens
+// obtained by assuming an agency get refreshed before they expire instead
+// of failing the whole apply.
+type agencyRefresherRoundTripper struct {
+	Rt     http.RoundTripper
+	Config *Config
+}
+
+func (rt *agencyRefresherRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.Rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	log.Printf("[DEBUG] assume_agency credentials expired, re-assuming chain")
+	if refreshErr := rt.Config.assumeAgencyChain(); refreshErr != nil {
+		return resp, refreshErr
+	}
+
+	// req.Body was already drained by the first RoundTrip above, so a
+	// retried POST/PUT/PATCH must rebuild it from GetBody or it would
+	// silently resend an empty body.
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, fmt.Errorf("Error rebuilding request body to retry after assume_agency refresh: %s", err)
+		}
+		req.Body = body
+	}
+
+	// assumeAgencyChain wrapped the new client's transport in a fresh
+	// refresher; retry through its inner transport so the retry happens once.
+	retryRt := rt.Config.HwClient.HTTPClient.Transport
+	if refresher, ok := retryRt.(*agencyRefresherRoundTripper); ok {
+		retryRt = refresher.Rt
+	}
+	return retryRt.RoundTrip(req)
+}
+
+func expandAssumeAgencies(raw []interface{}) []AssumeAgency {
+	agencies := make([]AssumeAgency, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		agencies[i] = AssumeAgency{
+			AgencyName:      m["agency_name"].(string),
+			DomainName:      m["domain_name"].(string),
+			DurationSeconds: m["duration_seconds"].(int),
+		}
+	}
+	return agencies
+}
@@ -0,0 +1,32 @@
+package huaweicloud
+
+import "testing"
+
+func TestMinAssumeAgencyDuration(t *testing.T) {
+	cases := []struct {
+		name     string
+		agencies []AssumeAgency
+		want     int
+	}{
+		{"empty", nil, 0},
+		{"single", []AssumeAgency{{DurationSeconds: 1800}}, 1800},
+		{"shortest hop wins", []AssumeAgency{
+			{DurationSeconds: 3600},
+			{DurationSeconds: 900},
+			{DurationSeconds: 1800},
+		}, 900},
+		{"zero durations ignored", []AssumeAgency{
+			{DurationSeconds: 0},
+			{DurationSeconds: 1200},
+		}, 1200},
+		{"all zero", []AssumeAgency{{DurationSeconds: 0}}, 0},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := minAssumeAgencyDuration(tt.agencies); got != tt.want {
+				t.Errorf("minAssumeAgencyDuration() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RegionAlias is one entry of the provider's `regions` block: an extra
+// region a single provider instance is allowed to operate against, keyed
+// by name (the alias is an informational label only, resources still
+// address the region by its `name`).
+type RegionAlias struct {
+	Alias     string
+	Name      string
+	ProjectID string
+}
+
+func expandRegionAliases(raw []interface{}) []RegionAlias {
+	regions := make([]RegionAlias, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		regions[i] = RegionAlias{
+			Alias:     m["alias"].(string),
+			Name:      m["name"].(string),
+			ProjectID: m["project_id"].(string),
+		}
+	}
+	return regions
+}
+
+// resolveRegions pre-resolves the project ID of every region declared in
+// c.Regions and stores it in c.RegionProjectIDMap, so that resources using
+// a `region` override don't pay the loadUserProjects round-trip on first
+// use. Regions are resolved concurrently since they're independent lookups.
+func (c *Config) resolveRegions() error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(c.Regions))
+
+	for i, region := range c.Regions {
+		if region.ProjectID != "" {
+			c.RPLock.Lock()
+			c.RegionProjectIDMap[region.Name] = region.ProjectID
+			c.RPLock.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, region RegionAlias) {
+			defer wg.Done()
+
+			c.RPLock.Lock()
+			_, ok := c.RegionProjectIDMap[region.Name]
+			c.RPLock.Unlock()
+			if ok {
+				return
+			}
+
+			// The network round-trip runs without RPLock held, so the
+			// goroutines for other regions aren't blocked behind it; the
+			// lock is only reacquired to write the result into the
+			// shared map.
+			all, err := c.fetchUserProjects(c.HwClient, region.Name)
+			if err != nil {
+				errs[i] = fmt.Errorf("error resolving project ID for region %q: %s", region.Name, err)
+				return
+			}
+
+			c.RPLock.Lock()
+			for _, item := range all {
+				c.RegionProjectIDMap[item.Name] = item.ID
+			}
+			c.RPLock.Unlock()
+		}(i, region)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
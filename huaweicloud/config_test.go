@@ -0,0 +1,47 @@
+package huaweicloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestOAuth2TokenSourceRefreshRetriesOnError guards against
+// startAutoRefresh's goroutine exiting for good after a single transient
+// refresh() error: it should keep retrying on oauth2RefreshRetryDelay
+// instead of leaving the stale token in place forever.
+func TestOAuth2TokenSourceRefreshRetriesOnError(t *testing.T) {
+	failNext := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failNext {
+			failNext = false
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"refreshed-token","expires_in":60}`))
+	}))
+	defer server.Close()
+
+	source := &oauth2TokenSource{
+		config: &Config{
+			IdentityEndpoint:   server.URL,
+			OAuth2ClientID:     "client-id",
+			OAuth2ClientSecret: "client-secret",
+		},
+	}
+	source.token = "stale-token"
+	source.expiresAt = time.Now().Add(oauth2RefreshMargin)
+	source.startAutoRefresh()
+	defer close(source.stopRefresh)
+
+	deadline := time.Now().Add(oauth2RefreshRetryDelay + 5*time.Second)
+	for time.Now().Before(deadline) {
+		if source.Token() == "refreshed-token" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected refresh to retry after a transient error and succeed, got token %q", source.Token())
+}
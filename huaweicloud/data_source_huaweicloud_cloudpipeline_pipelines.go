@@ -0,0 +1,97 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/pipelines"
+)
+
+// dataSourceCloudPipelinePipelinesV2 looks up the pipelines in a project,
+// to reference an existing pipeline's ID or surface its latest run status
+// in outputs.
+func dataSourceCloudPipelinePipelinesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPipelinePipelinesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"pipelines": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPipelinePipelinesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	listOpts := pipelines.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	allPipelines, err := pipelines.List(client, projectID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPipeline pipelines: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allPipelines))
+	for i, p := range allPipelines {
+		result[i] = map[string]interface{}{
+			"id":            p.ID,
+			"name":          p.Name,
+			"status":        p.Status,
+			"latest_run_id": p.LatestRunID,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("pipelines", result)
+
+	return nil
+}
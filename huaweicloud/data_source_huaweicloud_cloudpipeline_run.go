@@ -0,0 +1,78 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/pipelineruns"
+)
+
+// dataSourceCloudPipelineRunV2 exposes a single pipeline run's detail
+// (ShowPipelineRunDetail), so callers can reference the latest run's
+// status in outputs without scripting a lookup against the console.
+func dataSourceCloudPipelineRunV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPipelineRunV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"run_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"finished_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudPipelineRunV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	run, err := pipelineruns.GetDetail(client, d.Get("project_id").(string), d.Get("pipeline_id").(string), d.Get("run_id").(string)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPipeline run: %s", err)
+	}
+
+	d.SetId(run.RunID)
+	d.Set("region", region)
+	d.Set("status", run.Status)
+	d.Set("started_at", run.StartedAt)
+	d.Set("finished_at", run.FinishedAt)
+
+	return nil
+}
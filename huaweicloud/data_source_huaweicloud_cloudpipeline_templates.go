@@ -0,0 +1,92 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/templates"
+)
+
+// dataSourceCloudPipelineTemplatesV2 looks up the templates available in a
+// project, so their IDs can be fed into
+// huaweicloud_cloudpipeline_pipeline_from_template.
+func dataSourceCloudPipelineTemplatesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPipelineTemplatesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPipelineTemplatesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	listOpts := templates.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	allTemplates, err := templates.List(client, projectID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPipeline templates: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allTemplates))
+	for i, t := range allTemplates {
+		result[i] = map[string]interface{}{
+			"id":          t.ID,
+			"name":        t.Name,
+			"description": t.Description,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("templates", result)
+
+	return nil
+}
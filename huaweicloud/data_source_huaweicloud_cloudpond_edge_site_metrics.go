@@ -0,0 +1,104 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/metrics"
+)
+
+// dataSourceCloudPondEdgeSiteMetricsV1 wraps ListEdgeSiteMetrics, exposing
+// the CPU/memory/storage utilization samples for an edge site over the
+// requested period.
+func dataSourceCloudPondEdgeSiteMetricsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPondEdgeSiteMetricsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"edge_site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1h",
+			},
+
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"collected_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPondEdgeSiteMetricsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	edgeSiteID := d.Get("edge_site_id").(string)
+	listOpts := metrics.ListOpts{
+		Period: d.Get("period").(string),
+		Filter: d.Get("filter").(string),
+	}
+
+	allMetrics, err := metrics.List(client, edgeSiteID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPond edge site metrics: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allMetrics))
+	for i, m := range allMetrics {
+		result[i] = map[string]interface{}{
+			"name":         m.Name,
+			"value":        m.Value,
+			"unit":         m.Unit,
+			"collected_at": m.CollectedAt,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("metrics", result)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/quotas"
+)
+
+// dataSourceCloudPondQuotasV1 exposes the per-resource-type quotas for
+// CloudPond (edge sites, racks, storage pools), so callers can check
+// headroom before provisioning another huaweicloud_cloudpond_edge_site.
+func dataSourceCloudPondQuotasV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPondQuotasV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPondQuotasV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	allQuotas, err := quotas.List(client)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPond quotas: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allQuotas))
+	for i, q := range allQuotas {
+		result[i] = map[string]interface{}{
+			"type":  q.Type,
+			"used":  q.Used,
+			"quota": q.Quota,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("quotas", result)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/racks"
+)
+
+// dataSourceCloudPondRacksV1 lists the racks actually reporting in on an
+// edge site (ListRacks), so a huaweicloud_cloudpond_edge_site's declared
+// `rack` blocks can be diffed against reality.
+func dataSourceCloudPondRacksV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPondRacksV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"edge_site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"racks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPondRacksV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	edgeSiteID := d.Get("edge_site_id").(string)
+	allRacks, err := racks.List(client, edgeSiteID)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPond racks: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allRacks))
+	for i, r := range allRacks {
+		result[i] = map[string]interface{}{
+			"name":   r.Name,
+			"model":  r.Model,
+			"sn":     r.SN,
+			"status": r.Status,
+		}
+	}
+
+	d.SetId(edgeSiteID)
+	d.Set("region", region)
+	d.Set("racks", result)
+
+	return nil
+}
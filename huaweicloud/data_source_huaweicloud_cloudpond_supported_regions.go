@@ -0,0 +1,63 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/regions"
+)
+
+// dataSourceCloudPondSupportedRegionsV1 lists the regions CloudPond edge
+// sites can be registered against.
+func dataSourceCloudPondSupportedRegionsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudPondSupportedRegionsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudPondSupportedRegionsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.CloudPondV1Client(config.Region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	allRegions, err := regions.List(client)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPond supported regions: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allRegions))
+	for i, r := range allRegions {
+		result[i] = map[string]interface{}{
+			"id":   r.ID,
+			"name": r.Name,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("regions", result)
+
+	return nil
+}
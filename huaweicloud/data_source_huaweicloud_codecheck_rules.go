@@ -0,0 +1,101 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/rules"
+)
+
+// dataSourceCodeCheckRulesV1 lists the rules available for a language, for
+// building a huaweicloud_codecheck_ruleset's rule_ids.
+func dataSourceCodeCheckRulesV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCodeCheckRulesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"language": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCodeCheckRulesV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	listOpts := rules.ListOpts{
+		Language: d.Get("language").(string),
+		Severity: d.Get("severity").(string),
+	}
+
+	allRules, err := rules.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck rules: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allRules))
+	for i, r := range allRules {
+		result[i] = map[string]interface{}{
+			"id":          r.ID,
+			"name":        r.Name,
+			"language":    r.Language,
+			"severity":    r.Severity,
+			"description": r.Description,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("rules", result)
+
+	return nil
+}
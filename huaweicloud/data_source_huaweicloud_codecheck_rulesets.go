@@ -0,0 +1,91 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/rulesets"
+)
+
+// dataSourceCodeCheckRulesetsV1 lists the rulesets visible to the project,
+// including the built-in ones CodeCheck ships alongside any created via
+// huaweicloud_codecheck_ruleset.
+func dataSourceCodeCheckRulesetsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCodeCheckRulesetsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"language": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"rulesets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCodeCheckRulesetsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	listOpts := rulesets.ListOpts{
+		Language: d.Get("language").(string),
+	}
+
+	allRulesets, err := rulesets.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck rulesets: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allRulesets))
+	for i, rs := range allRulesets {
+		result[i] = map[string]interface{}{
+			"id":         rs.ID,
+			"name":       rs.Name,
+			"language":   rs.Language,
+			"is_default": rs.IsDefault,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("rulesets", result)
+
+	return nil
+}
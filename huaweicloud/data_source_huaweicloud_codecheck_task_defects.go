@@ -0,0 +1,137 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/tasks"
+)
+
+// dataSourceCodeCheckTaskDefectsV1 wraps ShowTaskDefects and
+// ShowTaskDefectsStatistic, exposing both the individual findings of a
+// huaweicloud_codecheck_task's latest run and the rolled-up counts by
+// severity.
+func dataSourceCodeCheckTaskDefectsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCodeCheckTaskDefectsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"task_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"defects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"file_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"line": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"critical_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"major_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"minor_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"suggestion_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCodeCheckTaskDefectsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	taskID := d.Get("task_id").(string)
+	listOpts := tasks.ListDefectsOpts{
+		Severity: d.Get("severity").(string),
+	}
+
+	allDefects, err := tasks.ShowTaskDefects(client, taskID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck task %s defects: %s", taskID, err)
+	}
+
+	result := make([]map[string]interface{}, len(allDefects))
+	for i, def := range allDefects {
+		result[i] = map[string]interface{}{
+			"id":        def.ID,
+			"rule_id":   def.RuleID,
+			"file_path": def.FilePath,
+			"line":      def.Line,
+			"severity":  def.Severity,
+			"message":   def.Message,
+		}
+	}
+
+	stats, err := tasks.ShowTaskDefectsStatistic(client, taskID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck task %s defect statistics: %s", taskID, err)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("defects", result)
+	d.Set("critical_count", stats.Critical)
+	d.Set("major_count", stats.Major)
+	d.Set("minor_count", stats.Minor)
+	d.Set("suggestion_count", stats.Suggestion)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/tasks"
+)
+
+// dataSourceCodeCheckTaskLogV1 exposes the raw analysis log of a
+// huaweicloud_codecheck_task's latest run, useful for debugging a failed
+// RunTask outside of the summarized defect counts.
+func dataSourceCodeCheckTaskLogV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCodeCheckTaskLogV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"task_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCodeCheckTaskLogV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	taskID := d.Get("task_id").(string)
+	log, err := tasks.ShowTaskLog(client, taskID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck task %s log: %s", taskID, err)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("content", log.Content)
+
+	return nil
+}
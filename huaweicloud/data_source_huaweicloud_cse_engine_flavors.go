@@ -0,0 +1,87 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/flavors"
+)
+
+// dataSourceCseEngineFlavorsV2 lists the microservice engine flavors
+// available in a region, for picking a `flavor` on
+// huaweicloud_cse_microservice_engine.
+func dataSourceCseEngineFlavorsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCseEngineFlavorsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_instances": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCseEngineFlavorsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	allFlavors, err := flavors.List(client)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CSE engine flavors: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allFlavors))
+	for i, f := range allFlavors {
+		result[i] = map[string]interface{}{
+			"id":              f.ID,
+			"name":            f.Name,
+			"ram":             f.RAM,
+			"max_instances":   f.MaxInstances,
+			"max_connections": f.MaxConnections,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("flavors", result)
+
+	return nil
+}
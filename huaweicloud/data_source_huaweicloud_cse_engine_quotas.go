@@ -0,0 +1,86 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/quotas"
+)
+
+// dataSourceCseEngineQuotasV2 exposes the per-resource-type quotas for CSE
+// microservice engines, so callers can check headroom before provisioning
+// another huaweicloud_cse_microservice_engine.
+func dataSourceCseEngineQuotasV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCseEngineQuotasV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCseEngineQuotasV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	listOpts := quotas.ListOpts{
+		Type: d.Get("type").(string),
+	}
+
+	allQuotas, err := quotas.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CSE engine quotas: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allQuotas))
+	for i, q := range allQuotas {
+		result[i] = map[string]interface{}{
+			"type":  q.Type,
+			"used":  q.Used,
+			"quota": q.Quota,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("quotas", result)
+
+	return nil
+}
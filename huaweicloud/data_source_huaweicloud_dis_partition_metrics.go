@@ -0,0 +1,88 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/partitions"
+)
+
+// dataSourceDisPartitionMetricsV2 exposes the per-partition ingestion
+// metrics of a DIS stream (ShowPartitionMetrics), to size downstream
+// huaweicloud_dis_transfer_task_* resources.
+func dataSourceDisPartitionMetricsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDisPartitionMetricsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"partitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incoming_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"incoming_records": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDisPartitionMetricsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	allMetrics, err := partitions.ListMetrics(client, streamName)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud DIS partition metrics: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allMetrics))
+	for i, m := range allMetrics {
+		result[i] = map[string]interface{}{
+			"partition_id":     m.PartitionID,
+			"status":           m.Status,
+			"incoming_bytes":   m.IncomingBytes,
+			"incoming_records": m.IncomingRecords,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("partitions", result)
+
+	return nil
+}
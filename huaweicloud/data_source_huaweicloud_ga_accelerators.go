@@ -0,0 +1,96 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/accelerators"
+)
+
+func dataSourceGaAcceleratorsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGaAcceleratorsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"accelerators": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGaAcceleratorsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	listOpts := accelerators.ListOpts{
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+	}
+
+	allAccelerators, err := accelerators.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud GA accelerators: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allAccelerators))
+	for i, a := range allAccelerators {
+		result[i] = map[string]interface{}{
+			"id":          a.ID,
+			"name":        a.Name,
+			"enabled":     a.Enabled,
+			"description": a.Description,
+			"status":      a.Status,
+			"ip_address":  a.IPAddress,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("accelerators", result)
+
+	return nil
+}
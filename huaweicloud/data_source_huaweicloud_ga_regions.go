@@ -0,0 +1,75 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/regions"
+)
+
+func dataSourceGaRegionsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGaRegionsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"area": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGaRegionsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	listOpts := regions.ListOpts{
+		Type: d.Get("type").(string),
+	}
+
+	allRegions, err := regions.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud GA regions: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allRegions))
+	for i, r := range allRegions {
+		result[i] = map[string]interface{}{
+			"id":   r.ID,
+			"type": r.Type,
+			"area": r.Area,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("regions", result)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/rfs/v1/stacks"
+)
+
+// dataSourceRfsStackV1 looks up an existing huaweicloud_rfs_stack by name,
+// exposing its outputs for consumption by resources that don't manage the
+// stack themselves.
+func dataSourceRfsStackV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRfsStackV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRfsStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	stack, err := stacks.GetByName(client, name).Extract()
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud RFS stack %s: %s", name, err)
+	}
+
+	d.SetId(stack.ID)
+	d.Set("region", region)
+	d.Set("status", stack.Status)
+	d.Set("outputs", stack.Outputs)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/availabilityzones"
+)
+
+// dataSourceVpnGatewayAvailabilityZonesV5 lists the AZs a VPN gateway can
+// be created in for a given attachment/HA combination, so callers can
+// populate huaweicloud_vpn_gateway's availability_zones without hardcoding
+// region-specific AZ names.
+func dataSourceVpnGatewayAvailabilityZonesV5() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVpnGatewayAvailabilityZonesV5Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ha_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceVpnGatewayAvailabilityZonesV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	listOpts := availabilityzones.ListOpts{
+		AttachmentType: d.Get("attachment_type").(string),
+		HAMode:         d.Get("ha_mode").(string),
+	}
+
+	azs, err := availabilityzones.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud VPN gateway availability zones: %s", err)
+	}
+
+	names := make([]string, len(azs))
+	for i, az := range azs {
+		names[i] = az.Name
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("names", names)
+
+	return nil
+}
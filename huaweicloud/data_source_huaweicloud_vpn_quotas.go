@@ -0,0 +1,86 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/quotas"
+)
+
+// dataSourceVpnQuotasV5 exposes the per-resource-type quotas for the native
+// VPN service, so callers can check headroom before provisioning gateways
+// or connections.
+func dataSourceVpnQuotasV5() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVpnQuotasV5Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpnQuotasV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	listOpts := quotas.ListOpts{
+		Type: d.Get("type").(string),
+	}
+
+	allQuotas, err := quotas.List(client, listOpts)
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud VPN quotas: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(allQuotas))
+	for i, q := range allQuotas {
+		result[i] = map[string]interface{}{
+			"type":  q.Type,
+			"used":  q.Used,
+			"quota": q.Quota,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("region", region)
+	d.Set("quotas", result)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/members"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// lbV2ResourceType enumerates the LBaaS v2 resource kinds that
+// waitForLBV2Resource knows how to poll.
+type lbV2ResourceType string
+
+const (
+	lbV2LoadBalancer lbV2ResourceType = "loadbalancer"
+	lbV2Listener     lbV2ResourceType = "listener"
+	lbV2Pool         lbV2ResourceType = "pool"
+	lbV2Monitor      lbV2ResourceType = "monitor"
+	// lbV2Member IDs are composite, "<pool_id>/<member_id>", since members
+	// are only reachable through their parent pool.
+	lbV2Member lbV2ResourceType = "member"
+)
+
+var lbV2PendingStatuses = []string{"PENDING_CREATE", "PENDING_UPDATE", "PENDING_DELETE"}
+
+// lbV2MutexKey is the osMutexKV key callers should hold for the duration of
+// a full create/update/delete mutation (API call plus wait) against a given
+// load balancer, so concurrent pool/listener/member operations on the same
+// load balancer serialize instead of racing on 409s. waitForLBV2Resource
+// itself only polls status and does not acquire this lock.
+func lbV2MutexKey(lbID string) string {
+	return "huaweicloud_lb_loadbalancer/" + lbID
+}
+
+// waitForLBV2Resource polls an LBaaS v2 resource until it reaches one of
+// targetStatus, modeled on the compute-operation waiter pattern used
+// elsewhere in this provider, and treats a 404 while waiting for "DELETED"
+// as success. Callers mutating a sub-resource of a load balancer are
+// responsible for holding the osMutexKV lock keyed by lbV2MutexKey around
+// their whole create/update/delete sequence; this function does not lock
+// anything itself.
+func waitForLBV2Resource(client *golangsdk.ServiceClient, resourceType lbV2ResourceType, id string, targetStatus []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     targetStatus,
+		Pending:    lbV2PendingStatuses,
+		Refresh:    resourceLBV2StateRefreshFunc(client, resourceType, id),
+		Timeout:    timeout,
+		Delay:      1 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if isTargetingDeleted(targetStatus) {
+			if _, ok := err.(*resource.NotFoundError); ok {
+				return nil
+			}
+		}
+		return fmt.Errorf("Error waiting for HuaweiCloud LB %s %s to become %v: %s", resourceType, id, targetStatus, err)
+	}
+
+	return nil
+}
+
+func isTargetingDeleted(targetStatus []string) bool {
+	for _, s := range targetStatus {
+		if s == "DELETED" {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceLBV2StateRefreshFunc(client *golangsdk.ServiceClient, resourceType lbV2ResourceType, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		switch resourceType {
+		case lbV2LoadBalancer:
+			lb, err := loadbalancers.Get(client, id).Extract()
+			if err != nil {
+				return checkLBV2Deleted(err, lb)
+			}
+			if lb.ProvisioningStatus == "ERROR" {
+				return lb, lb.ProvisioningStatus, fmt.Errorf("HuaweiCloud LB loadbalancer %s is in ERROR state", id)
+			}
+			return lb, lb.ProvisioningStatus, nil
+
+		case lbV2Listener:
+			listener, err := listeners.Get(client, id).Extract()
+			if err != nil {
+				return checkLBV2Deleted(err, listener)
+			}
+			if listener.ProvisioningStatus == "ERROR" {
+				return listener, listener.ProvisioningStatus, fmt.Errorf("HuaweiCloud LB listener %s is in ERROR state", id)
+			}
+			return listener, listener.ProvisioningStatus, nil
+
+		case lbV2Pool:
+			pool, err := pools.Get(client, id).Extract()
+			if err != nil {
+				return checkLBV2Deleted(err, pool)
+			}
+			if pool.ProvisioningStatus == "ERROR" {
+				return pool, pool.ProvisioningStatus, fmt.Errorf("HuaweiCloud LB pool %s is in ERROR state", id)
+			}
+			return pool, pool.ProvisioningStatus, nil
+
+		case lbV2Monitor:
+			monitor, err := monitors.Get(client, id).Extract()
+			if err != nil {
+				return checkLBV2Deleted(err, monitor)
+			}
+			if monitor.ProvisioningStatus == "ERROR" {
+				return monitor, monitor.ProvisioningStatus, fmt.Errorf("HuaweiCloud LB monitor %s is in ERROR state", id)
+			}
+			return monitor, monitor.ProvisioningStatus, nil
+
+		case lbV2Member:
+			parts := strings.SplitN(id, "/", 2)
+			if len(parts) != 2 {
+				return nil, "", fmt.Errorf("invalid lb member id %s, expected <pool_id>/<member_id>", id)
+			}
+			member, err := members.Get(client, parts[0], parts[1]).Extract()
+			if err != nil {
+				return checkLBV2Deleted(err, member)
+			}
+			if member.ProvisioningStatus == "ERROR" {
+				return member, member.ProvisioningStatus, fmt.Errorf("HuaweiCloud LB member %s is in ERROR state", id)
+			}
+			return member, member.ProvisioningStatus, nil
+		}
+
+		return nil, "", fmt.Errorf("unknown LB v2 resource type: %s", resourceType)
+	}
+}
+
+func checkLBV2Deleted(err error, resourceValue interface{}) (interface{}, string, error) {
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		return resourceValue, "DELETED", nil
+	}
+	return nil, "", err
+}
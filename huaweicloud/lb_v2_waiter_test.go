@@ -0,0 +1,67 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// TestAccLBV2Waiter_backToBack creates two listeners and two pools on the
+// same load balancer, neither pair referencing the other, so Terraform's
+// own dependency graph schedules the two chains concurrently instead of
+// serializing them. This is what actually exercises the locking in
+// lbV2MutexKey/osMutexKV instead of relying on an attribute chain
+// (pool -> member) that Terraform would serialize on its own regardless of
+// whether the provider locks anything.
+func TestAccLBV2Waiter_backToBack(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckULB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLBV2WaiterConfig_backToBack,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("huaweicloud_lb_pool.pool_1", "id"),
+					resource.TestCheckResourceAttrSet("huaweicloud_lb_pool.pool_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+var TestAccLBV2WaiterConfig_backToBack = fmt.Sprintf(`
+resource "huaweicloud_lb_loadbalancer" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "huaweicloud_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "huaweicloud_lb_listener" "listener_2" {
+  name            = "listener_2"
+  protocol        = "HTTP"
+  protocol_port   = 8081
+  loadbalancer_id = huaweicloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_lb_listener.listener_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_2" {
+  name        = "pool_2"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_lb_listener.listener_2.id
+}
+`, OS_SUBNET_ID)
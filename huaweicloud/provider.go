@@ -16,11 +16,12 @@ func Provider() terraform.ResourceProvider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				DefaultFunc:  schema.EnvDefaultFunc("OS_ACCESS_KEY", nil),
-				Description:  descriptions["access_key"],
-				RequiredWith: []string{"secret_key"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_ACCESS_KEY", nil),
+				Description:   descriptions["access_key"],
+				RequiredWith:  []string{"secret_key"},
+				ConflictsWith: []string{"oauth2_token", "oauth2_client_id"},
 			},
 
 			"secret_key": {
@@ -82,18 +83,54 @@ func Provider() terraform.ResourceProvider {
 			},
 
 			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("OS_PASSWORD", ""),
-				Description: descriptions["password"],
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_PASSWORD", ""),
+				Description:   descriptions["password"],
+				ConflictsWith: []string{"oauth2_token", "oauth2_client_id"},
 			},
 
 			"token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_AUTH_TOKEN", ""),
+				Description:   descriptions["token"],
+				ConflictsWith: []string{"oauth2_token", "oauth2_client_id"},
+			},
+
+			"oauth2_token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_OAUTH2_TOKEN", ""),
+				Description:   descriptions["oauth2_token"],
+				ConflictsWith: []string{"access_key", "password", "token"},
+			},
+
+			"oauth2_client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("OS_OAUTH2_CLIENT_ID", ""),
+				Description:   descriptions["oauth2_client_id"],
+				RequiredWith:  []string{"oauth2_client_secret"},
+				ConflictsWith: []string{"access_key", "password", "token"},
+			},
+
+			"oauth2_client_secret": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_OAUTH2_CLIENT_SECRET", ""),
+				Description:  descriptions["oauth2_client_secret"],
+				RequiredWith: []string{"oauth2_client_id"},
+			},
+
+			"oauth2_scope": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("OS_AUTH_TOKEN", ""),
-				Description: descriptions["token"],
+				DefaultFunc: schema.EnvDefaultFunc("OS_OAUTH2_SCOPE", ""),
+				Description: descriptions["oauth2_scope"],
 			},
 
 			"domain_id": {
@@ -189,6 +226,89 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_ENTERPRISE_PROJECT_ID", ""),
 				Description: descriptions["enterprise_project_id"],
 			},
+
+			"use_hcs_sdk": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_USE_HCS_SDK", false),
+				Description: descriptions["use_hcs_sdk"],
+			},
+
+			"rfs_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_RFS_ENDPOINT", ""),
+				Description: descriptions["rfs_endpoint"],
+			},
+
+			"rfs_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_RFS_PROJECT_ID", ""),
+				Description: descriptions["rfs_project_id"],
+			},
+
+			"assume_agency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agency_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"duration_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  3600,
+						},
+					},
+				},
+				Description: descriptions["assume_agency"],
+			},
+
+			"assumed_domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"assumed_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"regions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Description: descriptions["regions"],
+			},
+
+			"dns_custom_lines": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["dns_custom_lines"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -197,19 +317,36 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_cce_cluster":                 dataSourceCCEClusterV3(),
 			"huaweicloud_cce_node":                    dataSourceCCENodeV3(),
 			"huaweicloud_cdm_flavors":                 dataSourceCdmFlavorV1(),
+			"huaweicloud_cloudpipeline_pipelines":    dataSourceCloudPipelinePipelinesV2(),
+			"huaweicloud_cloudpipeline_run":          dataSourceCloudPipelineRunV2(),
+			"huaweicloud_cloudpipeline_templates":    dataSourceCloudPipelineTemplatesV2(),
+			"huaweicloud_cloudpond_edge_site_metrics": dataSourceCloudPondEdgeSiteMetricsV1(),
+			"huaweicloud_cloudpond_quotas":            dataSourceCloudPondQuotasV1(),
+			"huaweicloud_cloudpond_racks":             dataSourceCloudPondRacksV1(),
+			"huaweicloud_cloudpond_supported_regions": dataSourceCloudPondSupportedRegionsV1(),
+			"huaweicloud_codecheck_rules":             dataSourceCodeCheckRulesV1(),
+			"huaweicloud_codecheck_rulesets":          dataSourceCodeCheckRulesetsV1(),
+			"huaweicloud_codecheck_task_defects":      dataSourceCodeCheckTaskDefectsV1(),
+			"huaweicloud_codecheck_task_log":          dataSourceCodeCheckTaskLogV1(),
 			"huaweicloud_compute_flavors":             DataSourceEcsFlavors(),
 			"huaweicloud_csbs_backup":                 dataSourceCSBSBackupV1(),
 			"huaweicloud_csbs_backup_policy":          dataSourceCSBSBackupPolicyV1(),
+			"huaweicloud_cse_engine_flavors":          dataSourceCseEngineFlavorsV2(),
+			"huaweicloud_cse_engine_quotas":           dataSourceCseEngineQuotasV2(),
 			"huaweicloud_cts_tracker":                 dataSourceCTSTrackerV1(),
 			"huaweicloud_dcs_az":                      dataSourceDcsAZV1(),
 			"huaweicloud_dcs_maintainwindow":          dataSourceDcsMaintainWindowV1(),
 			"huaweicloud_dcs_product":                 dataSourceDcsProductV1(),
 			"huaweicloud_dds_flavors":                 dataSourceDDSFlavorV3(),
+			"huaweicloud_dis_app_consumer_state":      dataSourceDisAppConsumerStateV2(),
 			"huaweicloud_dis_partition":               dataSourceDisPartitionV2(),
+			"huaweicloud_dis_partition_metrics":       dataSourceDisPartitionMetricsV2(),
 			"huaweicloud_dms_az":                      dataSourceDmsAZV1(),
 			"huaweicloud_dms_product":                 dataSourceDmsProductV1(),
 			"huaweicloud_dms_maintainwindow":          dataSourceDmsMaintainWindowV1(),
 			"huaweicloud_enterprise_project":          DataSourceEnterpriseProject(),
+			"huaweicloud_ga_accelerators":             dataSourceGaAcceleratorsV1(),
+			"huaweicloud_ga_regions":                  dataSourceGaRegionsV1(),
 			"huaweicloud_gaussdb_mysql_configuration": dataSourceGaussdbMysqlConfigurations(),
 			"huaweicloud_gaussdb_mysql_flavors":       dataSourceGaussdbMysqlFlavors(),
 			"huaweicloud_gaussdb_mysql_instance":      dataSourceGaussDBMysqlInstance(),
@@ -223,6 +360,7 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_networking_secgroup":         dataSourceNetworkingSecGroupV2(),
 			"huaweicloud_obs_bucket_object":           dataSourceObsBucketObject(),
 			"huaweicloud_rds_flavors":                 dataSourceRdsFlavorV3(),
+			"huaweicloud_rfs_stack":                   dataSourceRfsStackV1(),
 			"huaweicloud_sfs_file_system":             dataSourceSFSFileSystemV2(),
 			"huaweicloud_vbs_backup_policy":           dataSourceVBSBackupPolicyV2(),
 			"huaweicloud_vbs_backup":                  dataSourceVBSBackupV2(),
@@ -234,6 +372,8 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_vpc_route_ids":               dataSourceVPCRouteIdsV2(),
 			"huaweicloud_vpc_subnet":                  DataSourceVpcSubnetV1(),
 			"huaweicloud_vpc_subnet_ids":              DataSourceVpcSubnetIdsV1(),
+			"huaweicloud_vpn_gateway_availability_zones": dataSourceVpnGatewayAvailabilityZonesV5(),
+			"huaweicloud_vpn_quotas":                     dataSourceVpnQuotasV5(),
 			// Legacy
 			"huaweicloud_images_image_v2":           dataSourceImagesImageV2(),
 			"huaweicloud_networking_port_v2":        dataSourceNetworkingPortV2(),
@@ -293,7 +433,15 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_cdm_cluster":                     resourceCdmClusterV1(),
 			"huaweicloud_cdn_domain":                      resourceCdnDomainV1(),
 			"huaweicloud_ces_alarmrule":                   resourceAlarmRule(),
+			"huaweicloud_cloudpipeline_pipeline":           resourceCloudPipelinePipelineV2(),
+			"huaweicloud_cloudpipeline_pipeline_from_template": resourceCloudPipelinePipelineFromTemplateV2(),
+			"huaweicloud_cloudpipeline_template":           resourceCloudPipelineTemplateV2(),
+			"huaweicloud_cloudpond_edge_site":              resourceCloudPondEdgeSiteV1(),
+			"huaweicloud_cloudpond_storage_pool":           resourceCloudPondStoragePoolV1(),
 			"huaweicloud_cloudtable_cluster":              resourceCloudtableClusterV2(),
+			"huaweicloud_codecheck_ruleset":               resourceCodeCheckRulesetV1(),
+			"huaweicloud_codecheck_task":                  resourceCodeCheckTaskV1(),
+			"huaweicloud_codecheck_task_ruleset":          resourceCodeCheckTaskRulesetV1(),
 			"huaweicloud_compute_instance":                resourceComputeInstanceV2(),
 			"huaweicloud_compute_interface_attach":        resourceComputeInterfaceAttachV2(),
 			"huaweicloud_compute_keypair":                 resourceComputeKeypairV2(),
@@ -305,23 +453,41 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_cs_route":                        resourceCsRouteV1(),
 			"huaweicloud_csbs_backup":                     resourceCSBSBackupV1(),
 			"huaweicloud_csbs_backup_policy":              resourceCSBSBackupPolicyV1(),
+			"huaweicloud_cse_governance_policy":           resourceCseGovernancePolicyV2(),
+			"huaweicloud_cse_microservice_engine":         resourceCseMicroserviceEngineV2(),
+			"huaweicloud_cse_microservice_route_rule":     resourceCseMicroserviceRouteRuleV2(),
+			"huaweicloud_cse_nacos_namespace":             resourceCseNacosNamespaceV2(),
 			"huaweicloud_css_cluster":                     resourceCssClusterV1(),
 			"huaweicloud_css_snapshot":                    resourceCssSnapshot(),
 			"huaweicloud_cts_tracker":                     resourceCTSTrackerV1(),
 			"huaweicloud_dcs_instance":                    resourceDcsInstanceV1(),
-			"huaweicloud_dds_instance":                    resourceDdsInstanceV3(),
-			"huaweicloud_dis_stream":                      resourceDisStreamV2(),
-			"huaweicloud_dli_queue":                       resourceDliQueueV1(),
+			"huaweicloud_dds_instance":                     resourceDdsInstanceV3(),
+			"huaweicloud_dis_app":                          resourceDisAppV2(),
+			"huaweicloud_dis_stream":                       resourceDisStreamV2(),
+			"huaweicloud_dis_transfer_task_cloudtable":     resourceDisTransferTaskCloudtableV2(),
+			"huaweicloud_dis_transfer_task_dli":            resourceDisTransferTaskDliV2(),
+			"huaweicloud_dis_transfer_task_dws":            resourceDisTransferTaskDwsV2(),
+			"huaweicloud_dis_transfer_task_mrs":            resourceDisTransferTaskMrsV2(),
+			"huaweicloud_dis_transfer_task_obs":            resourceDisTransferTaskObsV2(),
+			"huaweicloud_dli_queue":                        resourceDliQueueV1(),
 			"huaweicloud_dms_group":                       resourceDmsGroupsV1(),
 			"huaweicloud_dms_instance":                    resourceDmsInstancesV1(),
 			"huaweicloud_dms_queue":                       resourceDmsQueuesV1(),
 			"huaweicloud_dns_ptrrecord":                   ResourceDNSPtrRecordV2(),
 			"huaweicloud_dns_recordset":                   ResourceDNSRecordSetV2(),
+			"huaweicloud_dns_recordset_line":              ResourceDNSRecordSetV2Line(),
 			"huaweicloud_dns_zone":                        ResourceDNSZoneV2(),
+			"huaweicloud_eps_resource_migrate":            resourceEpsResourceMigrateV1(),
 			"huaweicloud_dws_cluster":                     resourceDwsCluster(),
 			"huaweicloud_evs_snapshot":                    resourceEvsSnapshotV2(),
 			"huaweicloud_evs_volume":                      resourceEvsStorageVolumeV3(),
 			"huaweicloud_fgs_function":                    resourceFgsFunctionV2(),
+			"huaweicloud_ga_accelerator":                  resourceGaAcceleratorV1(),
+			"huaweicloud_ga_endpoint":                     resourceGaEndpointV1(),
+			"huaweicloud_ga_endpoint_group":               resourceGaEndpointGroupV1(),
+			"huaweicloud_ga_health_check":                 resourceGaHealthCheckV1(),
+			"huaweicloud_ga_ip_group":                     resourceGaIPGroupV1(),
+			"huaweicloud_ga_listener":                     resourceGaListenerV1(),
 			"huaweicloud_gaussdb_cassandra_instance":      resourceGeminiDBInstanceV3(),
 			"huaweicloud_gaussdb_mysql_instance":          resourceGaussDBInstance(),
 			"huaweicloud_gaussdb_opengauss_instance":      resourceOpenGaussInstance(),
@@ -366,6 +532,8 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_rds_instance":                    resourceRdsInstanceV3(),
 			"huaweicloud_rds_parametergroup":              resourceRdsConfigurationV3(),
 			"huaweicloud_rds_read_replica_instance":       resourceRdsReadReplicaInstance(),
+			"huaweicloud_rfs_stack":                       resourceRfsStackV1(),
+			"huaweicloud_rfs_template":                    resourceRfsTemplateV1(),
 			"huaweicloud_sfs_access_rule":                 resourceSFSAccessRuleV2(),
 			"huaweicloud_sfs_file_system":                 resourceSFSFileSystemV2(),
 			"huaweicloud_sfs_turbo":                       resourceSFSTurbo(),
@@ -380,6 +548,11 @@ func Provider() terraform.ResourceProvider {
 			"huaweicloud_vpc_peering_connection_accepter": resourceVpcPeeringConnectionAccepterV2(),
 			"huaweicloud_vpc_route":                       ResourceVPCRouteV2(),
 			"huaweicloud_vpc_subnet":                      ResourceVpcSubnetV1(),
+			"huaweicloud_vpn_connection":                  resourceVpnConnectionV5(),
+			"huaweicloud_vpn_connection_monitor":          resourceVpnConnectionMonitorV5(),
+			"huaweicloud_vpn_customer_gateway":            resourceVpnCustomerGatewayV5(),
+			"huaweicloud_vpn_gateway":                     resourceVpnGatewayV5(),
+			"huaweicloud_vpn_gateway_certificate":         resourceVpnGatewayCertificateV5(),
 			"huaweicloud_vpnaas_endpoint_group":           resourceVpnEndpointGroupV2(),
 			"huaweicloud_vpnaas_ike_policy":               resourceVpnIKEPolicyV2(),
 			"huaweicloud_vpnaas_ipsec_policy":             resourceVpnIPSecPolicyV2(),
@@ -533,6 +706,15 @@ func init() {
 
 		"token": "Authentication token to use as an alternative to username/password.",
 
+		"oauth2_token": "An OAuth2 access token to authenticate with, as an\n" +
+			"alternative to AK/SK, username/password or token.",
+
+		"oauth2_client_id": "The OAuth2 client ID used to obtain and refresh an access token.",
+
+		"oauth2_client_secret": "The OAuth2 client secret used to obtain and refresh an access token.",
+
+		"oauth2_scope": "The OAuth2 scope to request when obtaining an access token.",
+
 		"domain_id": "The ID of the Domain to scope to (Identity v3).",
 
 		"domain_name": "The name of the Domain to scope to (Identity v3).",
@@ -556,6 +738,27 @@ func init() {
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
 
 		"enterprise_project_id": "enterprise project id",
+
+		"use_hcs_sdk": "Build resource clients on the official huaweicloud-sdk-go-v3 SDK\n" +
+			"instead of Gophercloud, for resources that support it.",
+
+		"rfs_endpoint": "A custom Resource Formation Service endpoint, for deployments not\n" +
+			"yet reachable at the region-derived default.",
+
+		"rfs_project_id": "The project ID to use for Resource Formation Service requests,\n" +
+			"if different from the region's default project.",
+
+		"assume_agency": "A chain of agencies to assume, in order, after authenticating with\n" +
+			"the primary credentials. Enables cross-account Terraform runs\n" +
+			"without hand-managing temporary credentials.",
+
+		"regions": "Regions this provider instance is allowed to operate against, beyond\n" +
+			"the primary `region`. Their project IDs are resolved up front so that\n" +
+			"resources can opt into a different region via their own `region`\n" +
+			"argument without declaring a separate provider alias per region.",
+
+		"dns_custom_lines": "Additional DNS resolution line IDs to accept on top of the bundled\n" +
+			"list, for ISP or view lines specific to this account.",
 	}
 }
 
@@ -589,6 +792,10 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		Password:            d.Get("password").(string),
 		Region:              d.Get("region").(string),
 		Token:               d.Get("token").(string),
+		OAuth2Token:         d.Get("oauth2_token").(string),
+		OAuth2ClientID:      d.Get("oauth2_client_id").(string),
+		OAuth2ClientSecret:  d.Get("oauth2_client_secret").(string),
+		OAuth2Scope:         d.Get("oauth2_scope").(string),
 		TenantID:            d.Get("tenant_id").(string),
 		TenantName:          tenant_name,
 		Username:            d.Get("user_name").(string),
@@ -599,6 +806,12 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		Cloud:               d.Get("cloud").(string),
 		MaxRetries:          d.Get("max_retries").(int),
 		EnterpriseProjectID: d.Get("enterprise_project_id").(string),
+		UseHcsSDK:           d.Get("use_hcs_sdk").(bool),
+		RfsEndpoint:         d.Get("rfs_endpoint").(string),
+		RfsProjectID:        d.Get("rfs_project_id").(string),
+		AssumeAgencies:      expandAssumeAgencies(d.Get("assume_agency").([]interface{})),
+		Regions:             expandRegionAliases(d.Get("regions").([]interface{})),
+		CustomDNSLines:      expandStringList(d.Get("dns_custom_lines").([]interface{})),
 		TerraformVersion:    terraformVersion,
 		RegionProjectIDMap:  make(map[string]string),
 		RPLock:              new(sync.Mutex),
@@ -612,5 +825,14 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		config.RegionProjectIDMap[config.Region] = config.HwClient.ProjectID
 	}
 
+	if len(config.Regions) > 0 {
+		if err := config.resolveRegions(); err != nil {
+			return nil, err
+		}
+	}
+
+	d.Set("assumed_domain_id", config.AssumedDomainID)
+	d.Set("assumed_project_id", config.AssumedProjectID)
+
 	return &config, nil
 }
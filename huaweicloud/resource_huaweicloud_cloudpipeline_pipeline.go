@@ -0,0 +1,232 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/pipelineruns"
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/pipelines"
+)
+
+// cloudPipelineCommonSchema returns the schema fields shared by
+// huaweicloud_cloudpipeline_pipeline and
+// huaweicloud_cloudpipeline_pipeline_from_template.
+func cloudPipelineCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"project_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"run_on_apply": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"latest_run_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// resourceCloudPipelinePipelineV2 manages a CloudPipeline pipeline defined
+// inline via CreatePipelineByTemplate, as opposed to
+// huaweicloud_cloudpipeline_pipeline_from_template which references an
+// existing huaweicloud_cloudpipeline_template by ID.
+func resourceCloudPipelinePipelineV2() *schema.Resource {
+	resourceSchema := cloudPipelineCommonSchema()
+	resourceSchema["definition"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceCloudPipelinePipelineV2Create,
+		Read:   resourceCloudPipelinePipelineV2Read,
+		Update: resourceCloudPipelinePipelineV2Update,
+		Delete: resourceCloudPipelinePipelineV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceCloudPipelinePipelineV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	createOpts := pipelines.CreateByTemplateOpts{
+		ProjectID:  d.Get("project_id").(string),
+		Name:       d.Get("name").(string),
+		Definition: d.Get("definition").(string),
+	}
+
+	pipeline, err := pipelines.CreateByTemplate(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline pipeline: %s", err)
+	}
+
+	d.SetId(pipeline.ID)
+
+	if d.Get("run_on_apply").(bool) {
+		if err := cloudPipelineRunOnApply(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudPipelinePipelineV2Read(d, meta)
+}
+
+func resourceCloudPipelinePipelineV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	return cloudPipelineRead(client, d, region)
+}
+
+func resourceCloudPipelinePipelineV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	if d.HasChange("run_on_apply") && d.Get("run_on_apply").(bool) {
+		if err := cloudPipelineRunOnApply(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudPipelinePipelineV2Read(d, meta)
+}
+
+func resourceCloudPipelinePipelineV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	return cloudPipelineDelete(client, d)
+}
+
+// cloudPipelineRead is shared by both pipeline resources: they only differ
+// in how they're created.
+func cloudPipelineRead(client *golangsdk.ServiceClient, d *schema.ResourceData, region string) error {
+	pipeline, err := pipelines.Get(client, d.Get("project_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CloudPipeline pipeline")
+	}
+
+	d.Set("region", region)
+	d.Set("name", pipeline.Name)
+	d.Set("status", pipeline.Status)
+	d.Set("latest_run_id", pipeline.LatestRunID)
+
+	return nil
+}
+
+// cloudPipelineDelete calls DeletePipeline, falling back to the more
+// forceful RemovePipeline if the pipeline still has running builds that
+// DeletePipeline refuses to tear down.
+func cloudPipelineDelete(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	projectID := d.Get("project_id").(string)
+
+	err := pipelines.Delete(client, projectID, d.Id()).ExtractErr()
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(golangsdk.ErrDefault409); ok {
+		if rmErr := pipelines.Remove(client, projectID, d.Id()).ExtractErr(); rmErr != nil {
+			return fmt.Errorf("Error removing HuaweiCloud CloudPipeline pipeline: %s", rmErr)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("Error deleting HuaweiCloud CloudPipeline pipeline: %s", err)
+}
+
+// cloudPipelineRunOnApply starts a run via RunPipeline/StartNewPipeline and
+// polls BatchShowPipelinesLatestStatus until the run reaches a terminal
+// state, storing the run ID as a computed attribute.
+func cloudPipelineRunOnApply(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	projectID := d.Get("project_id").(string)
+
+	run, err := pipelineruns.StartNew(client, projectID, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error running HuaweiCloud CloudPipeline pipeline %s: %s", d.Id(), err)
+	}
+
+	d.Set("latest_run_id", run.RunID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RUNNING", "INIT", "QUEUED"},
+		Target:     []string{"SUCCESS"},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			statuses, err := pipelineruns.BatchShowLatestStatus(client, projectID, []string{d.Id()})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(statuses) == 0 {
+				return nil, "", fmt.Errorf("HuaweiCloud CloudPipeline pipeline %s has no run status", d.Id())
+			}
+			status := statuses[0].Status
+			if status == "FAILED" || status == "CANCELED" {
+				return nil, "", fmt.Errorf("HuaweiCloud CloudPipeline pipeline %s run %s ended with status %s", d.Id(), run.RunID, status)
+			}
+			return statuses[0], status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud CloudPipeline pipeline %s run %s to complete: %s", d.Id(), run.RunID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/pipelines"
+)
+
+// resourceCloudPipelinePipelineFromTemplateV2 manages a CloudPipeline
+// pipeline instantiated from an existing huaweicloud_cloudpipeline_template
+// via CreatePipelineByTemplateId, as opposed to
+// huaweicloud_cloudpipeline_pipeline which defines the pipeline inline.
+func resourceCloudPipelinePipelineFromTemplateV2() *schema.Resource {
+	resourceSchema := cloudPipelineCommonSchema()
+	resourceSchema["template_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceCloudPipelinePipelineFromTemplateV2Create,
+		Read:   resourceCloudPipelinePipelineFromTemplateV2Read,
+		Update: resourceCloudPipelinePipelineFromTemplateV2Update,
+		Delete: resourceCloudPipelinePipelineFromTemplateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceCloudPipelinePipelineFromTemplateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	createOpts := pipelines.CreateByTemplateIDOpts{
+		ProjectID:  d.Get("project_id").(string),
+		Name:       d.Get("name").(string),
+		TemplateID: d.Get("template_id").(string),
+	}
+
+	pipeline, err := pipelines.CreateByTemplateID(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline pipeline from template: %s", err)
+	}
+
+	d.SetId(pipeline.ID)
+
+	if d.Get("run_on_apply").(bool) {
+		if err := cloudPipelineRunOnApply(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudPipelinePipelineFromTemplateV2Read(d, meta)
+}
+
+func resourceCloudPipelinePipelineFromTemplateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	return cloudPipelineRead(client, d, region)
+}
+
+func resourceCloudPipelinePipelineFromTemplateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	if d.HasChange("run_on_apply") && d.Get("run_on_apply").(bool) {
+		if err := cloudPipelineRunOnApply(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCloudPipelinePipelineFromTemplateV2Read(d, meta)
+}
+
+func resourceCloudPipelinePipelineFromTemplateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	return cloudPipelineDelete(client, d)
+}
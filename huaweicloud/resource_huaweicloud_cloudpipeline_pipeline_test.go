@@ -0,0 +1,45 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// TestAccCloudPipelinePipeline_runFailed creates a pipeline whose definition
+// has no runnable stages and sets run_on_apply so Create triggers
+// cloudPipelineRunOnApply. A pipeline run with no stages always ends in
+// FAILED, so this exercises the Refresh func's handling of a terminal
+// FAILED/CANCELED run rather than letting StateChangeConf.Target report it
+// as a successful apply.
+func TestAccCloudPipelinePipeline_runFailed(t *testing.T) {
+	rName := fmt.Sprintf("acpttest-cloudpipeline-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudPipelinePipeline_runFailed(rName),
+				ExpectError: regexp.MustCompile(`run .* ended with status`),
+			},
+		},
+	})
+}
+
+func testAccCloudPipelinePipeline_runFailed(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_cloudpipeline_pipeline" "pipeline_1" {
+  name         = "%s"
+  project_id   = "%s"
+  run_on_apply = true
+
+  definition = jsonencode({
+    stages = []
+  })
+}
+`, rName, OS_PROJECT_ID)
+}
@@ -0,0 +1,137 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpipeline/v2/templates"
+)
+
+// resourceCloudPipelineTemplateV2 manages a reusable CloudPipeline
+// template, which huaweicloud_cloudpipeline_pipeline_from_template
+// instantiates into a runnable pipeline.
+func resourceCloudPipelineTemplateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudPipelineTemplateV2Create,
+		Read:   resourceCloudPipelineTemplateV2Read,
+		Update: resourceCloudPipelineTemplateV2Update,
+		Delete: resourceCloudPipelineTemplateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceCloudPipelineTemplateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	createOpts := templates.CreateOpts{
+		ProjectID:   d.Get("project_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Definition:  d.Get("definition").(string),
+	}
+
+	template, err := templates.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline template: %s", err)
+	}
+
+	d.SetId(template.ID)
+
+	return resourceCloudPipelineTemplateV2Read(d, meta)
+}
+
+func resourceCloudPipelineTemplateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	template, err := templates.Get(client, d.Get("project_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CloudPipeline template")
+	}
+
+	d.Set("region", region)
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("definition", template.Definition)
+
+	return nil
+}
+
+func resourceCloudPipelineTemplateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "definition") {
+		updateOpts := templates.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			Definition:  d.Get("definition").(string),
+		}
+		if _, err := templates.Update(client, d.Get("project_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CloudPipeline template: %s", err)
+		}
+	}
+
+	return resourceCloudPipelineTemplateV2Read(d, meta)
+}
+
+func resourceCloudPipelineTemplateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPipelineV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPipeline client: %s", err)
+	}
+
+	if err := templates.Delete(client, d.Get("project_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CloudPipeline template: %s", err)
+	}
+
+	return nil
+}
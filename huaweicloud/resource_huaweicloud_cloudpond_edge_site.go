@@ -0,0 +1,210 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/edgesites"
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/racks"
+)
+
+// resourceCloudPondEdgeSiteV1 manages a CloudPond edge site, the on-premises
+// rack deployment that huaweicloud_cloudpond_storage_pool provisions
+// capacity on.
+func resourceCloudPondEdgeSiteV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudPondEdgeSiteV1Create,
+		Read:   resourceCloudPondEdgeSiteV1Read,
+		Update: resourceCloudPondEdgeSiteV1Update,
+		Delete: resourceCloudPondEdgeSiteV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"city": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			// rack enumerates the racks this edge site is expected to have,
+			// so plans diff the declared inventory against ListRacks.
+			"rack": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudPondEdgeSiteV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	epsID := d.Get("enterprise_project_id").(string)
+	if epsID == "" {
+		epsID = config.EnterpriseProjectID
+	}
+
+	createOpts := edgesites.CreateOpts{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		City:                d.Get("city").(string),
+		EnterpriseProjectID: epsID,
+		Racks:               expandCloudPondEdgeSiteRacks(d.Get("rack").([]interface{})),
+	}
+
+	site, err := edgesites.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond edge site: %s", err)
+	}
+
+	d.SetId(site.ID)
+
+	return resourceCloudPondEdgeSiteV1Read(d, meta)
+}
+
+func resourceCloudPondEdgeSiteV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	site, err := edgesites.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CloudPond edge site")
+	}
+
+	d.Set("region", region)
+	d.Set("name", site.Name)
+	d.Set("description", site.Description)
+	d.Set("city", site.City)
+	d.Set("enterprise_project_id", site.EnterpriseProjectID)
+	d.Set("status", site.Status)
+	allRacks, err := racks.List(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CloudPond racks: %s", err)
+	}
+	reported := make([]edgesites.Rack, len(allRacks))
+	for i, r := range allRacks {
+		reported[i] = edgesites.Rack{Name: r.Name, Model: r.Model, SN: r.SN}
+	}
+	d.Set("rack", flattenCloudPondEdgeSiteRacks(reported))
+
+	return nil
+}
+
+func resourceCloudPondEdgeSiteV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	if d.HasChanges("description", "rack") {
+		updateOpts := edgesites.UpdateOpts{
+			Description: d.Get("description").(string),
+			Racks:       expandCloudPondEdgeSiteRacks(d.Get("rack").([]interface{})),
+		}
+		if _, err := edgesites.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CloudPond edge site: %s", err)
+		}
+	}
+
+	return resourceCloudPondEdgeSiteV1Read(d, meta)
+}
+
+func resourceCloudPondEdgeSiteV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	if err := edgesites.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CloudPond edge site: %s", err)
+	}
+
+	return nil
+}
+
+func expandCloudPondEdgeSiteRacks(raw []interface{}) []edgesites.Rack {
+	racks := make([]edgesites.Rack, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		racks[i] = edgesites.Rack{
+			Name:  m["name"].(string),
+			Model: m["model"].(string),
+			SN:    m["sn"].(string),
+		}
+	}
+	return racks
+}
+
+func flattenCloudPondEdgeSiteRacks(racks []edgesites.Rack) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(racks))
+	for i, r := range racks {
+		raw[i] = map[string]interface{}{
+			"name":  r.Name,
+			"model": r.Model,
+			"sn":    r.SN,
+		}
+	}
+	return raw
+}
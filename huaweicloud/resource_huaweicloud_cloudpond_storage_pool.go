@@ -0,0 +1,142 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cloudpond/v1/storagepools"
+)
+
+// resourceCloudPondStoragePoolV1 manages a CloudPond storage pool on an
+// edge site: Create creates the pool with the requested capacity, Update
+// changes capacity_gb in place, and Delete deletes the pool. A pool that
+// already exists on the edge site is not adopted.
+func resourceCloudPondStoragePoolV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudPondStoragePoolV1Create,
+		Read:   resourceCloudPondStoragePoolV1Read,
+		Update: resourceCloudPondStoragePoolV1Update,
+		Delete: resourceCloudPondStoragePoolV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"edge_site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"capacity_gb": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"used_capacity_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudPondStoragePoolV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	edgeSiteID := d.Get("edge_site_id").(string)
+	createOpts := storagepools.CreateOpts{
+		Name:       d.Get("name").(string),
+		CapacityGB: d.Get("capacity_gb").(int),
+	}
+
+	pool, err := storagepools.Create(client, edgeSiteID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond storage pool: %s", err)
+	}
+
+	d.SetId(pool.ID)
+
+	return resourceCloudPondStoragePoolV1Read(d, meta)
+}
+
+func resourceCloudPondStoragePoolV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	pool, err := storagepools.Get(client, d.Get("edge_site_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CloudPond storage pool")
+	}
+
+	d.Set("region", region)
+	d.Set("name", pool.Name)
+	d.Set("capacity_gb", pool.CapacityGB)
+	d.Set("used_capacity_gb", pool.UsedCapacityGB)
+	d.Set("status", pool.Status)
+
+	return nil
+}
+
+func resourceCloudPondStoragePoolV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	if d.HasChange("capacity_gb") {
+		updateOpts := storagepools.UpdateOpts{
+			CapacityGB: d.Get("capacity_gb").(int),
+		}
+		if _, err := storagepools.Update(client, d.Get("edge_site_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CloudPond storage pool: %s", err)
+		}
+	}
+
+	return resourceCloudPondStoragePoolV1Read(d, meta)
+}
+
+func resourceCloudPondStoragePoolV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CloudPondV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CloudPond client: %s", err)
+	}
+
+	if err := storagepools.Delete(client, d.Get("edge_site_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CloudPond storage pool: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/rulesets"
+)
+
+// resourceCodeCheckRulesetV1 manages a CodeCheck ruleset: a named
+// collection of rule IDs that a huaweicloud_codecheck_task can be bound to
+// via huaweicloud_codecheck_task_ruleset.
+func resourceCodeCheckRulesetV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCodeCheckRulesetV1Create,
+		Read:   resourceCodeCheckRulesetV1Read,
+		Update: resourceCodeCheckRulesetV1Update,
+		Delete: resourceCodeCheckRulesetV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"language": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"rule_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"set_as_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCodeCheckRulesetV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	createOpts := rulesets.CreateOpts{
+		Name:        d.Get("name").(string),
+		Language:    d.Get("language").(string),
+		Description: d.Get("description").(string),
+		RuleIDs:     expandCseEngineStringList(d.Get("rule_ids").([]interface{})),
+	}
+
+	ruleset, err := rulesets.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck ruleset: %s", err)
+	}
+
+	d.SetId(ruleset.ID)
+
+	if d.Get("set_as_default").(bool) {
+		if err := rulesets.SetDefaulTemplate(client, ruleset.ID).ExtractErr(); err != nil {
+			return fmt.Errorf("Error setting HuaweiCloud CodeCheck ruleset %s as default: %s", ruleset.ID, err)
+		}
+	}
+
+	return resourceCodeCheckRulesetV1Read(d, meta)
+}
+
+func resourceCodeCheckRulesetV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	ruleset, err := rulesets.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CodeCheck ruleset")
+	}
+
+	d.Set("region", region)
+	d.Set("name", ruleset.Name)
+	d.Set("language", ruleset.Language)
+	d.Set("description", ruleset.Description)
+	d.Set("rule_ids", ruleset.RuleIDs)
+	d.Set("is_default", ruleset.IsDefault)
+
+	return nil
+}
+
+func resourceCodeCheckRulesetV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	if d.HasChanges("description", "rule_ids") {
+		updateOpts := rulesets.UpdateOpts{
+			Description: d.Get("description").(string),
+			RuleIDs:     expandCseEngineStringList(d.Get("rule_ids").([]interface{})),
+		}
+		if _, err := rulesets.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CodeCheck ruleset: %s", err)
+		}
+	}
+
+	if d.HasChange("set_as_default") && d.Get("set_as_default").(bool) {
+		if err := rulesets.SetDefaulTemplate(client, d.Id()).ExtractErr(); err != nil {
+			return fmt.Errorf("Error setting HuaweiCloud CodeCheck ruleset %s as default: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCodeCheckRulesetV1Read(d, meta)
+}
+
+func resourceCodeCheckRulesetV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	if err := rulesets.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CodeCheck ruleset: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/tasks"
+)
+
+// resourceCodeCheckTaskV1 manages a CodeCheck static-analysis task bound to
+// an existing repo/project output. Setting run_on_change triggers RunTask
+// whenever the task's settings change, and blocks until the run completes,
+// so defect_count_* can be used to gate downstream resources with
+// precondition blocks.
+func resourceCodeCheckTaskV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCodeCheckTaskV1Create,
+		Read:   resourceCodeCheckTaskV1Read,
+		Update: resourceCodeCheckTaskV1Update,
+		Delete: resourceCodeCheckTaskV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"repo_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "master",
+			},
+
+			"run_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"defect_count_critical": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"defect_count_major": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"defect_count_minor": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"defect_count_suggestion": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCodeCheckTaskV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	createOpts := tasks.CreateOpts{
+		Name:      d.Get("name").(string),
+		ProjectID: d.Get("project_id").(string),
+		RepoID:    d.Get("repo_id").(string),
+		Branch:    d.Get("branch").(string),
+	}
+
+	task, err := tasks.CreateTask(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck task: %s", err)
+	}
+
+	d.SetId(task.ID)
+
+	if d.Get("run_on_change").(bool) {
+		if err := codeCheckRunTaskAndWait(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCodeCheckTaskV1Read(d, meta)
+}
+
+func resourceCodeCheckTaskV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	task, err := tasks.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CodeCheck task")
+	}
+
+	d.Set("region", region)
+	d.Set("name", task.Name)
+	d.Set("project_id", task.ProjectID)
+	d.Set("repo_id", task.RepoID)
+	d.Set("branch", task.Branch)
+	d.Set("status", task.Status)
+
+	stats, err := tasks.ShowTaskDefectsStatistic(client, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error querying HuaweiCloud CodeCheck task %s defect statistics: %s", d.Id(), err)
+	}
+	d.Set("defect_count_critical", stats.Critical)
+	d.Set("defect_count_major", stats.Major)
+	d.Set("defect_count_minor", stats.Minor)
+	d.Set("defect_count_suggestion", stats.Suggestion)
+
+	return nil
+}
+
+func resourceCodeCheckTaskV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	if d.HasChange("branch") {
+		updateOpts := tasks.UpdateSettingsOpts{
+			Branch: d.Get("branch").(string),
+		}
+		if _, err := tasks.UpdateTaskSettings(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CodeCheck task %s settings: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("run_on_change").(bool) && d.HasChange("branch") {
+		if err := codeCheckRunTaskAndWait(client, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceCodeCheckTaskV1Read(d, meta)
+}
+
+func resourceCodeCheckTaskV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	if err := tasks.StopTaskById(client, d.Id()).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error stopping HuaweiCloud CodeCheck task %s before delete: %s", d.Id(), err)
+		}
+	}
+
+	if err := tasks.DeleteTask(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CodeCheck task: %s", err)
+	}
+
+	return nil
+}
+
+// codeCheckRunTaskAndWait starts a run via RunTask and polls
+// ShowProgressDetail until the run reaches a terminal state.
+func codeCheckRunTaskAndWait(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	if err := tasks.RunTask(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error running HuaweiCloud CodeCheck task %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RUNNING", "QUEUED"},
+		Target:     []string{"SUCCESS"},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			progress, err := tasks.ShowProgressDetail(client, d.Id()).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			if progress.Status == "FAILED" || progress.Status == "CANCELED" {
+				return nil, "", fmt.Errorf("HuaweiCloud CodeCheck task %s run ended with status %s", d.Id(), progress.Status)
+			}
+			return progress, progress.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud CodeCheck task %s run to complete: %s", d.Id(), err)
+	}
+
+	return nil
+}
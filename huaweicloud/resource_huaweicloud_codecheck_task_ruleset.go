@@ -0,0 +1,102 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/codecheck/v1/tasks"
+)
+
+// resourceCodeCheckTaskRulesetV1 binds a huaweicloud_codecheck_ruleset to a
+// huaweicloud_codecheck_task via UpdateTaskRuleset. It's a separate
+// resource, rather than a field on huaweicloud_codecheck_task, so the
+// binding can be swapped without forcing the task to be recreated.
+func resourceCodeCheckTaskRulesetV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCodeCheckTaskRulesetV1CreateOrUpdate,
+		Read:   resourceCodeCheckTaskRulesetV1Read,
+		Update: resourceCodeCheckTaskRulesetV1CreateOrUpdate,
+		Delete: resourceCodeCheckTaskRulesetV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"task_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ruleset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceCodeCheckTaskRulesetV1CreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	taskID := d.Get("task_id").(string)
+	updateOpts := tasks.UpdateRulesetOpts{
+		RulesetID: d.Get("ruleset_id").(string),
+	}
+
+	if _, err := tasks.UpdateTaskRuleset(client, taskID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("Error binding HuaweiCloud CodeCheck ruleset to task %s: %s", taskID, err)
+	}
+
+	d.SetId(taskID)
+
+	return resourceCodeCheckTaskRulesetV1Read(d, meta)
+}
+
+func resourceCodeCheckTaskRulesetV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	task, err := tasks.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CodeCheck task ruleset binding")
+	}
+
+	d.Set("region", region)
+	d.Set("task_id", task.ID)
+	d.Set("ruleset_id", task.RulesetID)
+
+	return nil
+}
+
+func resourceCodeCheckTaskRulesetV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CodeCheckV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CodeCheck client: %s", err)
+	}
+
+	updateOpts := tasks.UpdateRulesetOpts{
+		RulesetID: "",
+	}
+	if _, err := tasks.UpdateTaskRuleset(client, d.Id(), updateOpts).Extract(); err != nil {
+		return fmt.Errorf("Error unbinding HuaweiCloud CodeCheck ruleset from task %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
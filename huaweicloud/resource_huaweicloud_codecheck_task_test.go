@@ -0,0 +1,42 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// TestAccCodeCheckTask_runFailed points a task at a branch that does not
+// exist on the repo, so RunTask's analysis run is guaranteed to terminate
+// as FAILED. This exercises codeCheckRunTaskAndWait's Refresh func, which
+// must surface that FAILED/CANCELED status as an error instead of letting
+// StateChangeConf.Target report it as a successful apply.
+func TestAccCodeCheckTask_runFailed(t *testing.T) {
+	rName := fmt.Sprintf("acpttest-codecheck-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodeCheckTask_runFailed(rName),
+				ExpectError: regexp.MustCompile(`run ended with status`),
+			},
+		},
+	})
+}
+
+func testAccCodeCheckTask_runFailed(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_codecheck_task" "task_1" {
+  name          = "%s"
+  project_id    = "%s"
+  repo_id       = "%s"
+  branch        = "branch-that-does-not-exist"
+  run_on_change = true
+}
+`, rName, OS_PROJECT_ID, OS_CODECHECK_REPO_ID)
+}
@@ -0,0 +1,243 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/governancepolicies"
+)
+
+// resourceCseGovernancePolicyV2 manages a retry or rate-limit policy
+// attached to a microservice on a huaweicloud_cse_microservice_engine.
+func resourceCseGovernancePolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCseGovernancePolicyV2Create,
+		Read:   resourceCseGovernancePolicyV2Read,
+		Update: resourceCseGovernancePolicyV2Update,
+		Delete: resourceCseGovernancePolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"engine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"retry", "rate-limit",
+				}, false),
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  3,
+						},
+						"per_try_timeout_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"retry_on": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"rate_limit": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"burst": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCseGovernancePolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	engineID := d.Get("engine_id").(string)
+	createOpts := governancepolicies.CreateOpts{
+		ServiceName: d.Get("service_name").(string),
+		Name:        d.Get("name").(string),
+		Kind:        d.Get("kind").(string),
+		Retry:       expandCseGovernancePolicyRetry(d.Get("retry").([]interface{})),
+		RateLimit:   expandCseGovernancePolicyRateLimit(d.Get("rate_limit").([]interface{})),
+	}
+
+	policy, err := governancepolicies.Create(client, engineID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE governance policy: %s", err)
+	}
+
+	d.SetId(policy.ID)
+
+	return resourceCseGovernancePolicyV2Read(d, meta)
+}
+
+func resourceCseGovernancePolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	policy, err := governancepolicies.Get(client, d.Get("engine_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CSE governance policy")
+	}
+
+	d.Set("region", region)
+	d.Set("service_name", policy.ServiceName)
+	d.Set("name", policy.Name)
+	d.Set("kind", policy.Kind)
+	d.Set("status", policy.Status)
+	d.Set("retry", flattenCseGovernancePolicyRetry(policy.Retry))
+	d.Set("rate_limit", flattenCseGovernancePolicyRateLimit(policy.RateLimit))
+
+	return nil
+}
+
+func resourceCseGovernancePolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if d.HasChanges("retry", "rate_limit") {
+		updateOpts := governancepolicies.UpdateOpts{
+			Retry:     expandCseGovernancePolicyRetry(d.Get("retry").([]interface{})),
+			RateLimit: expandCseGovernancePolicyRateLimit(d.Get("rate_limit").([]interface{})),
+		}
+		if _, err := governancepolicies.Update(client, d.Get("engine_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CSE governance policy: %s", err)
+		}
+	}
+
+	return resourceCseGovernancePolicyV2Read(d, meta)
+}
+
+func resourceCseGovernancePolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if err := governancepolicies.Delete(client, d.Get("engine_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CSE governance policy: %s", err)
+	}
+
+	return nil
+}
+
+func expandCseGovernancePolicyRetry(raw []interface{}) *governancepolicies.Retry {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &governancepolicies.Retry{
+		MaxAttempts:          m["max_attempts"].(int),
+		PerTryTimeoutSeconds: m["per_try_timeout_seconds"].(int),
+		RetryOn:              expandCseEngineStringList(m["retry_on"].([]interface{})),
+	}
+}
+
+func flattenCseGovernancePolicyRetry(r *governancepolicies.Retry) []map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"max_attempts":            r.MaxAttempts,
+			"per_try_timeout_seconds": r.PerTryTimeoutSeconds,
+			"retry_on":                r.RetryOn,
+		},
+	}
+}
+
+func expandCseGovernancePolicyRateLimit(raw []interface{}) *governancepolicies.RateLimit {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &governancepolicies.RateLimit{
+		Rate:  m["rate"].(int),
+		Burst: m["burst"].(int),
+	}
+}
+
+func flattenCseGovernancePolicyRateLimit(r *governancepolicies.RateLimit) []map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"rate":  r.Rate,
+			"burst": r.Burst,
+		},
+	}
+}
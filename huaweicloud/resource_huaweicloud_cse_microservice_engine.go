@@ -0,0 +1,338 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/engines"
+)
+
+// resourceCseMicroserviceEngineV2 manages a CSE microservice engine, the
+// dedicated service-registry/config-center pair that CSE governance
+// policies and route rules attach to.
+func resourceCseMicroserviceEngineV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCseMicroserviceEngineV2Create,
+		Read:   resourceCseMicroserviceEngineV2Read,
+		Update: resourceCseMicroserviceEngineV2Update,
+		Delete: resourceCseMicroserviceEngineV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"eip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"auth_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "NONE",
+				ValidateFunc: validation.StringInSlice([]string{
+					"NONE", "RBAC",
+				}, false),
+			},
+
+			"admin_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_registry_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"config_center_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCseMicroserviceEngineV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	createOpts := engines.CreateOpts{
+		Name:              d.Get("name").(string),
+		Flavor:            d.Get("flavor").(string),
+		VpcID:             d.Get("vpc_id").(string),
+		SubnetID:          d.Get("subnet_id").(string),
+		SecurityGroupID:   d.Get("security_group_id").(string),
+		AvailabilityZones: expandCseEngineStringList(d.Get("availability_zones").([]interface{})),
+		EipID:             d.Get("eip_id").(string),
+		AuthType:          d.Get("auth_type").(string),
+		AdminPassword:     d.Get("admin_password").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	job, err := engines.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE microservice engine: %s", err)
+	}
+
+	engineID, err := waitForCseEngineJob(client, job.JobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(engineID)
+
+	return resourceCseMicroserviceEngineV2Read(d, meta)
+}
+
+func resourceCseMicroserviceEngineV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	engine, err := engines.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CSE microservice engine")
+	}
+
+	d.Set("region", region)
+	d.Set("name", engine.Name)
+	d.Set("flavor", engine.Flavor)
+	d.Set("vpc_id", engine.VpcID)
+	d.Set("subnet_id", engine.SubnetID)
+	d.Set("security_group_id", engine.SecurityGroupID)
+	d.Set("availability_zones", engine.AvailabilityZones)
+	d.Set("auth_type", engine.AuthType)
+	d.Set("version", engine.Version)
+	d.Set("status", engine.Status)
+	d.Set("service_registry_addresses", flattenCseEngineAddresses(engine.ServiceRegistryAddresses))
+	d.Set("config_center_addresses", flattenCseEngineAddresses(engine.ConfigCenterAddresses))
+
+	return nil
+}
+
+func resourceCseMicroserviceEngineV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if d.HasChange("flavor") {
+		job, err := engines.Resize(client, d.Id(), engines.ResizeOpts{
+			Flavor: d.Get("flavor").(string),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("Error resizing HuaweiCloud CSE microservice engine: %s", err)
+		}
+		if _, err := waitForCseEngineJob(client, job.JobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("version") {
+		job, err := engines.Upgrade(client, d.Id(), engines.UpgradeOpts{
+			Version: d.Get("version").(string),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("Error upgrading HuaweiCloud CSE microservice engine: %s", err)
+		}
+		if _, err := waitForCseEngineJob(client, job.JobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("admin_password") {
+		job, err := engines.UpgradeConfig(client, d.Id(), engines.UpgradeConfigOpts{
+			AdminPassword: d.Get("admin_password").(string),
+		}).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CSE microservice engine config: %s", err)
+		}
+		if _, err := waitForCseEngineJob(client, job.JobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCseMicroserviceEngineV2Read(d, meta)
+}
+
+func resourceCseMicroserviceEngineV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	job, err := engines.Delete(client, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CSE microservice engine: %s", err)
+	}
+
+	_, err = waitForCseEngineJob(client, job.JobID, d.Timeout(schema.TimeoutDelete))
+	return err
+}
+
+func expandCseEngineStringList(raw []interface{}) []string {
+	list := make([]string, len(raw))
+	for i, v := range raw {
+		list[i] = v.(string)
+	}
+	return list
+}
+
+func flattenCseEngineAddresses(addresses []engines.Address) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(addresses))
+	for i, a := range addresses {
+		raw[i] = map[string]interface{}{
+			"public_address":  a.PublicAddress,
+			"private_address": a.PrivateAddress,
+		}
+	}
+	return raw
+}
+
+// waitForCseEngineJob polls ShowEngineJob until the async job behind an
+// engine create/resize/upgrade/delete finishes, and returns the job's
+// engine ID. A single failed job is retried once via RetryEngine before
+// giving up, since transient capacity errors are common on first creation.
+func waitForCseEngineJob(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) (string, error) {
+	var engineID string
+	retried := false
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			job, err := engines.GetJob(client, jobID).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+
+			if job.Status == "FAIL" && !retried {
+				retried = true
+				if _, retryErr := engines.Retry(client, job.EngineID).Extract(); retryErr != nil {
+					return nil, "", fmt.Errorf("Error retrying HuaweiCloud CSE engine job %s: %s", jobID, retryErr)
+				}
+				return job, "RUNNING", nil
+			}
+			if job.Status == "FAIL" {
+				return nil, "", fmt.Errorf("HuaweiCloud CSE engine job %s failed: %s", jobID, job.Message)
+			}
+
+			engineID = job.EngineID
+			return job, job.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return "", fmt.Errorf("Error waiting for HuaweiCloud CSE engine job %s to complete: %s", jobID, err)
+	}
+
+	return engineID, nil
+}
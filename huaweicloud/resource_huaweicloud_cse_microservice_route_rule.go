@@ -0,0 +1,184 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/routerules"
+)
+
+// resourceCseMicroserviceRouteRuleV2 manages a canary/weighted traffic
+// split across the versions (tags) of a microservice registered on a
+// huaweicloud_cse_microservice_engine.
+func resourceCseMicroserviceRouteRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCseMicroserviceRouteRuleV2Create,
+		Read:   resourceCseMicroserviceRouteRuleV2Read,
+		Update: resourceCseMicroserviceRouteRuleV2Update,
+		Delete: resourceCseMicroserviceRouteRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"engine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCseMicroserviceRouteRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	engineID := d.Get("engine_id").(string)
+	createOpts := routerules.CreateOpts{
+		ServiceName: d.Get("service_name").(string),
+		Name:        d.Get("name").(string),
+		Routes:      expandCseRouteRuleRoutes(d.Get("route").([]interface{})),
+	}
+
+	rule, err := routerules.Create(client, engineID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE microservice route rule: %s", err)
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCseMicroserviceRouteRuleV2Read(d, meta)
+}
+
+func resourceCseMicroserviceRouteRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	rule, err := routerules.Get(client, d.Get("engine_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CSE microservice route rule")
+	}
+
+	d.Set("region", region)
+	d.Set("service_name", rule.ServiceName)
+	d.Set("name", rule.Name)
+	d.Set("status", rule.Status)
+	d.Set("route", flattenCseRouteRuleRoutes(rule.Routes))
+
+	return nil
+}
+
+func resourceCseMicroserviceRouteRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if d.HasChange("route") {
+		updateOpts := routerules.UpdateOpts{
+			Routes: expandCseRouteRuleRoutes(d.Get("route").([]interface{})),
+		}
+		if _, err := routerules.Update(client, d.Get("engine_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CSE microservice route rule: %s", err)
+		}
+	}
+
+	return resourceCseMicroserviceRouteRuleV2Read(d, meta)
+}
+
+func resourceCseMicroserviceRouteRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if err := routerules.Delete(client, d.Get("engine_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CSE microservice route rule: %s", err)
+	}
+
+	return nil
+}
+
+func expandCseRouteRuleRoutes(raw []interface{}) []routerules.Route {
+	routes := make([]routerules.Route, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		tags := make(map[string]string)
+		for k, tv := range m["tags"].(map[string]interface{}) {
+			tags[k] = tv.(string)
+		}
+		routes[i] = routerules.Route{
+			Tags:   tags,
+			Weight: m["weight"].(int),
+		}
+	}
+	return routes
+}
+
+func flattenCseRouteRuleRoutes(routes []routerules.Route) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(routes))
+	for i, r := range routes {
+		raw[i] = map[string]interface{}{
+			"tags":   r.Tags,
+			"weight": r.Weight,
+		}
+	}
+	return raw
+}
@@ -0,0 +1,138 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/cse/v2/nacosnamespaces"
+)
+
+// resourceCseNacosNamespaceV2 manages a Nacos namespace on a
+// huaweicloud_cse_microservice_engine that was created with the Nacos
+// config-center flavor.
+func resourceCseNacosNamespaceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCseNacosNamespaceV2Create,
+		Read:   resourceCseNacosNamespaceV2Read,
+		Update: resourceCseNacosNamespaceV2Update,
+		Delete: resourceCseNacosNamespaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"engine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"quota": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  200,
+			},
+		},
+	}
+}
+
+func resourceCseNacosNamespaceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	engineID := d.Get("engine_id").(string)
+	createOpts := nacosnamespaces.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Quota:       d.Get("quota").(int),
+	}
+
+	namespace, err := nacosnamespaces.Create(client, engineID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE Nacos namespace: %s", err)
+	}
+
+	d.SetId(namespace.ID)
+
+	return resourceCseNacosNamespaceV2Read(d, meta)
+}
+
+func resourceCseNacosNamespaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	namespace, err := nacosnamespaces.Get(client, d.Get("engine_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "CSE Nacos namespace")
+	}
+
+	d.Set("region", region)
+	d.Set("name", namespace.Name)
+	d.Set("description", namespace.Description)
+	d.Set("quota", namespace.Quota)
+
+	return nil
+}
+
+func resourceCseNacosNamespaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if d.HasChanges("description", "quota") {
+		updateOpts := nacosnamespaces.UpdateOpts{
+			Description: d.Get("description").(string),
+			Quota:       d.Get("quota").(int),
+		}
+		if _, err := nacosnamespaces.Update(client, d.Get("engine_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud CSE Nacos namespace: %s", err)
+		}
+	}
+
+	return resourceCseNacosNamespaceV2Read(d, meta)
+}
+
+func resourceCseNacosNamespaceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.CseV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud CSE client: %s", err)
+	}
+
+	if err := nacosnamespaces.Delete(client, d.Get("engine_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud CSE Nacos namespace: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/apps"
+)
+
+// resourceDisAppV2 manages a DIS consumer app, the group that
+// huaweicloud_dis_app_consumer_state reports checkpoint progress for.
+func resourceDisAppV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDisAppV2Create,
+		Read:   resourceDisAppV2Read,
+		Delete: resourceDisAppV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDisAppV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	createOpts := apps.CreateOpts{
+		AppName: d.Get("name").(string),
+	}
+
+	if err := apps.Create(client, createOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS app: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceDisAppV2Read(d, meta)
+}
+
+func resourceDisAppV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	app, err := apps.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "DIS app")
+	}
+
+	d.Set("region", region)
+	d.Set("name", app.AppName)
+	d.Set("created_at", app.CreateTime)
+
+	return nil
+}
+
+func resourceDisAppV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	if err := apps.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud DIS app: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/apps"
+)
+
+func TestAccDisApp_basic(t *testing.T) {
+	var app apps.App
+	rName := fmt.Sprintf("acpttest-dis-app-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_dis_app.app_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDisAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisApp_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDisAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDisAppDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.disV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_dis_app" {
+			continue
+		}
+
+		_, err := apps.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("DIS app still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDisAppExists(n string, app *apps.App) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.disV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+		}
+
+		found, err := apps.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.AppName != rs.Primary.ID {
+			return fmt.Errorf("DIS app not found")
+		}
+
+		*app = *found
+
+		return nil
+	}
+}
+
+func testAccDisApp_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dis_app" "app_1" {
+  name = "%s"
+}
+`, rName)
+}
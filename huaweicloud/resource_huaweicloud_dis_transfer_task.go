@@ -0,0 +1,212 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/transfertasks"
+)
+
+// disTransferTaskCommonSchema returns the schema fields shared by every
+// huaweicloud_dis_transfer_task_* resource. Each destination-specific
+// resource embeds this and adds its own destination config block.
+func disTransferTaskCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"stream_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"state": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "RUNNING",
+			ValidateFunc: validation.StringInSlice([]string{
+				"RUNNING", "PAUSED",
+			}, false),
+		},
+
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		"tags": TagsSchema(),
+	}
+}
+
+// disTransferTaskCreate creates the transfer task via createFunc, waits for
+// it to become active, sets its tags and state, then reads it back.
+func disTransferTaskCreate(d *schema.ResourceData, meta interface{}, createFunc func(client *golangsdk.ServiceClient, streamName string) (string, error)) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName, err := createFunc(client, streamName)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS transfer task: %s", err)
+	}
+
+	d.SetId(taskName)
+
+	if err := waitForDisTransferTaskStatus(client, streamName, taskName, "RUNNING", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		if err := transfertasks.BatchCreateTags(client, streamName, taskName, ExpandResourceTags(tagRaw)); err != nil {
+			return fmt.Errorf("Error setting tags on HuaweiCloud DIS transfer task %s: %s", taskName, err)
+		}
+	}
+
+	if d.Get("state").(string) == "PAUSED" {
+		if err := transfertasks.BatchStop(client, streamName, []string{taskName}); err != nil {
+			return fmt.Errorf("Error pausing HuaweiCloud DIS transfer task %s: %s", taskName, err)
+		}
+		if err := waitForDisTransferTaskStatus(client, streamName, taskName, "PAUSED", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return disTransferTaskRead(d, meta)
+}
+
+func disTransferTaskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	task, err := transfertasks.Get(client, streamName, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "DIS transfer task")
+	}
+
+	d.Set("region", region)
+	d.Set("name", task.Name)
+	d.Set("status", task.Status)
+	if task.Status == "PAUSED" {
+		d.Set("state", "PAUSED")
+	} else {
+		d.Set("state", "RUNNING")
+	}
+	d.Set("tags", TagsToMap(task.Tags))
+
+	return nil
+}
+
+// disTransferTaskUpdate handles the attributes that are common across every
+// destination type: tags and the running/paused state. Destination-specific
+// config is ForceNew, so there's nothing else to update in place.
+func disTransferTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+
+	if d.HasChange("tags") {
+		oldRaw, newRaw := d.GetChange("tags")
+		oldTags := ExpandResourceTags(oldRaw.(map[string]interface{}))
+		newTags := ExpandResourceTags(newRaw.(map[string]interface{}))
+
+		if len(oldTags) > 0 {
+			if err := transfertasks.BatchDeleteTags(client, streamName, d.Id(), oldTags); err != nil {
+				return fmt.Errorf("Error deleting tags on HuaweiCloud DIS transfer task %s: %s", d.Id(), err)
+			}
+		}
+		if len(newTags) > 0 {
+			if err := transfertasks.BatchCreateTags(client, streamName, d.Id(), newTags); err != nil {
+				return fmt.Errorf("Error setting tags on HuaweiCloud DIS transfer task %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("state") {
+		target := d.Get("state").(string)
+		if target == "PAUSED" {
+			err = transfertasks.BatchStop(client, streamName, []string{d.Id()})
+		} else {
+			err = transfertasks.BatchStart(client, streamName, []string{d.Id()})
+		}
+		if err != nil {
+			return fmt.Errorf("Error updating state of HuaweiCloud DIS transfer task %s: %s", d.Id(), err)
+		}
+		if err := waitForDisTransferTaskStatus(client, streamName, d.Id(), target, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return disTransferTaskRead(d, meta)
+}
+
+func disTransferTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.disV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DIS client: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	if err := transfertasks.Delete(client, streamName, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud DIS transfer task: %s", err)
+	}
+
+	return nil
+}
+
+// waitForDisTransferTaskStatus polls ShowTransferTask until the task
+// reaches target ("RUNNING" or "PAUSED"), since BatchStartTransferTask and
+// BatchStopTransferTask are asynchronous.
+func waitForDisTransferTaskStatus(client *golangsdk.ServiceClient, streamName, taskName, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"STARTING", "STOPPING"},
+		Target:     []string{target},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			task, err := transfertasks.Get(client, streamName, taskName).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			return task, task.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud DIS transfer task %s to reach %s: %s", taskName, target, err)
+	}
+
+	return nil
+}
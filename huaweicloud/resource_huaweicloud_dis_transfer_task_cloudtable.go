@@ -0,0 +1,76 @@
+package huaweicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/transfertasks"
+)
+
+// resourceDisTransferTaskCloudtableV2 manages a DIS transfer task that
+// loads a stream's data into a CloudTable table.
+func resourceDisTransferTaskCloudtableV2() *schema.Resource {
+	resourceSchema := disTransferTaskCommonSchema()
+	resourceSchema["destination"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cluster_id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"table_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"column_family": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceDisTransferTaskCloudtableV2Create,
+		Read:   disTransferTaskRead,
+		Update: disTransferTaskUpdate,
+		Delete: disTransferTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceDisTransferTaskCloudtableV2Create(d *schema.ResourceData, meta interface{}) error {
+	return disTransferTaskCreate(d, meta, func(client *golangsdk.ServiceClient, streamName string) (string, error) {
+		destRaw := d.Get("destination").([]interface{})[0].(map[string]interface{})
+		createOpts := transfertasks.CreateCloudtableOpts{
+			TaskName:     d.Get("name").(string),
+			ClusterID:    destRaw["cluster_id"].(string),
+			TableName:    destRaw["table_name"].(string),
+			ColumnFamily: destRaw["column_family"].(string),
+		}
+
+		task, err := transfertasks.CreateCloudtable(client, streamName, createOpts).Extract()
+		if err != nil {
+			return "", err
+		}
+		return task.Name, nil
+	})
+}
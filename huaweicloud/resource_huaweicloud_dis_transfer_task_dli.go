@@ -0,0 +1,89 @@
+package huaweicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/transfertasks"
+)
+
+// resourceDisTransferTaskDliV2 manages a DIS transfer task that loads a
+// stream's data into a DLI table.
+func resourceDisTransferTaskDliV2() *schema.Resource {
+	resourceSchema := disTransferTaskCommonSchema()
+	resourceSchema["destination"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"database_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"table_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"queue_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"obs_bucket_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"deliver_time_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+					Default:  300,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceDisTransferTaskDliV2Create,
+		Read:   disTransferTaskRead,
+		Update: disTransferTaskUpdate,
+		Delete: disTransferTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceDisTransferTaskDliV2Create(d *schema.ResourceData, meta interface{}) error {
+	return disTransferTaskCreate(d, meta, func(client *golangsdk.ServiceClient, streamName string) (string, error) {
+		destRaw := d.Get("destination").([]interface{})[0].(map[string]interface{})
+		createOpts := transfertasks.CreateDliOpts{
+			TaskName:            d.Get("name").(string),
+			DatabaseName:        destRaw["database_name"].(string),
+			TableName:           destRaw["table_name"].(string),
+			QueueName:           destRaw["queue_name"].(string),
+			ObsBucketName:       destRaw["obs_bucket_name"].(string),
+			DeliverTimeInterval: destRaw["deliver_time_interval"].(int),
+		}
+
+		task, err := transfertasks.CreateDli(client, streamName, createOpts).Extract()
+		if err != nil {
+			return "", err
+		}
+		return task.Name, nil
+	})
+}
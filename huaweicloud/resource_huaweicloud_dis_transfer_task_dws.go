@@ -0,0 +1,95 @@
+package huaweicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/transfertasks"
+)
+
+// resourceDisTransferTaskDwsV2 manages a DIS transfer task that loads a
+// stream's data into a GaussDB(DWS) cluster table.
+func resourceDisTransferTaskDwsV2() *schema.Resource {
+	resourceSchema := disTransferTaskCommonSchema()
+	resourceSchema["destination"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cluster_id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"database_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"schema_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"table_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"user_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"user_password": {
+					Type:      schema.TypeString,
+					Required:  true,
+					ForceNew:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceDisTransferTaskDwsV2Create,
+		Read:   disTransferTaskRead,
+		Update: disTransferTaskUpdate,
+		Delete: disTransferTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceDisTransferTaskDwsV2Create(d *schema.ResourceData, meta interface{}) error {
+	return disTransferTaskCreate(d, meta, func(client *golangsdk.ServiceClient, streamName string) (string, error) {
+		destRaw := d.Get("destination").([]interface{})[0].(map[string]interface{})
+		createOpts := transfertasks.CreateDwsOpts{
+			TaskName:     d.Get("name").(string),
+			ClusterID:    destRaw["cluster_id"].(string),
+			DatabaseName: destRaw["database_name"].(string),
+			SchemaName:   destRaw["schema_name"].(string),
+			TableName:    destRaw["table_name"].(string),
+			UserName:     destRaw["user_name"].(string),
+			UserPassword: destRaw["user_password"].(string),
+		}
+
+		task, err := transfertasks.CreateDws(client, streamName, createOpts).Extract()
+		if err != nil {
+			return "", err
+		}
+		return task.Name, nil
+	})
+}
@@ -0,0 +1,90 @@
+package huaweicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dis/v2/transfertasks"
+)
+
+// resourceDisTransferTaskMrsV2 manages a DIS transfer task that loads a
+// stream's data into an MRS cluster via HDFS/OBS dump files.
+func resourceDisTransferTaskMrsV2() *schema.Resource {
+	resourceSchema := disTransferTaskCommonSchema()
+	resourceSchema["destination"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cluster_id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"file_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"partition_format": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"destination_file_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+					Default:  "text",
+				},
+				"deliver_time_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+					Default:  300,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceDisTransferTaskMrsV2Create,
+		Read:   disTransferTaskRead,
+		Update: disTransferTaskUpdate,
+		Delete: disTransferTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func resourceDisTransferTaskMrsV2Create(d *schema.ResourceData, meta interface{}) error {
+	return disTransferTaskCreate(d, meta, func(client *golangsdk.ServiceClient, streamName string) (string, error) {
+		destRaw := d.Get("destination").([]interface{})[0].(map[string]interface{})
+		createOpts := transfertasks.CreateMrsOpts{
+			TaskName:            d.Get("name").(string),
+			ClusterID:           destRaw["cluster_id"].(string),
+			FilePrefix:          destRaw["file_prefix"].(string),
+			PartitionFormat:     destRaw["partition_format"].(string),
+			DestinationFileType: destRaw["destination_file_type"].(string),
+			DeliverTimeInterval: destRaw["deliver_time_interval"].(int),
+		}
+
+		task, err := transfertasks.CreateMrs(client, streamName, createOpts).Extract()
+		if err != nil {
+			return "", err
+		}
+		return task.Name, nil
+	})
+}
@@ -0,0 +1,335 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
+)
+
+// ResourceDNSRecordSetV2 manages a record set belonging to a huaweicloud_dns_zone.
+// Huawei's DNS service allows several record sets with the same (name, type) to
+// coexist as long as they are pinned to different resolution lines, so the
+// resource ID is namespaced with the line to keep them independent in state.
+func ResourceDNSRecordSetV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSRecordSetV2Create,
+		Read:   resourceDNSRecordSetV2Read,
+		Update: resourceDNSRecordSetV2Update,
+		Delete: resourceDNSRecordSetV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "PTR", "NS", "CAA",
+				}, false),
+			},
+
+			"record": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// line selects an ISP/region resolution line (line-based resolution).
+			// Because Huawei DNS treats (name, type, line) as the identity of a
+			// record set, it is baked into the resource ID below.
+			"line": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default_view",
+			},
+
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+
+			"geo_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"continent": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subdivision": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// recordSetID packs the line into the Huawei-assigned recordset ID so that
+// two recordsets sharing (name, type) but differing only by line don't
+// collide in Terraform state.
+func recordSetID(zoneID, id, line string) string {
+	if line == "" {
+		return zoneID + "/" + id
+	}
+	return zoneID + "/" + id + "/" + line
+}
+
+func parseRecordSetID(compositeID string) (zoneID, id, line string, err error) {
+	parts := strings.SplitN(compositeID, "/", 3)
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("invalid huaweicloud_dns_recordset id: %s", compositeID)
+	}
+	zoneID = parts[0]
+	id = parts[1]
+	if len(parts) == 3 {
+		line = parts[2]
+	}
+	return
+}
+
+func expandDNSRecordSetGeoLocation(raw []interface{}) *recordsets.GeoLocation {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	v := raw[0].(map[string]interface{})
+	return &recordsets.GeoLocation{
+		ContinentCode:   v["continent"].(string),
+		CountryCode:     v["country"].(string),
+		SubdivisionCode: v["subdivision"].(string),
+	}
+}
+
+func flattenDNSRecordSetGeoLocation(geo *recordsets.GeoLocation) []map[string]interface{} {
+	if geo == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"continent":   geo.ContinentCode,
+			"country":     geo.CountryCode,
+			"subdivision": geo.SubdivisionCode,
+		},
+	}
+}
+
+func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	records := make([]string, len(d.Get("record").([]interface{})))
+	for i, v := range d.Get("record").([]interface{}) {
+		records[i] = v.(string)
+	}
+
+	createOpts := recordsets.CreateOpts{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		TTL:         d.Get("ttl").(int),
+		Description: d.Get("description").(string),
+		Records:     records,
+		Line:        d.Get("line").(string),
+		Weight:      d.Get("weight").(int),
+		GeoLocation: expandDNSRecordSetGeoLocation(d.Get("geo_location").([]interface{})),
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	n, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS record set: %s", err)
+	}
+
+	d.SetId(recordSetID(zoneID, n.ID, d.Get("line").(string)))
+
+	log.Printf("[DEBUG] Created HuaweiCloud DNS record set %s", d.Id())
+	return resourceDNSRecordSetV2Read(d, meta)
+}
+
+func resourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, id, line, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	n, err := recordsets.Get(dnsClient, zoneID, id).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "record_set")
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("name", n.Name)
+	d.Set("type", n.Type)
+	d.Set("ttl", n.TTL)
+	d.Set("description", n.Description)
+	d.Set("record", n.Records)
+	d.Set("status", n.Status)
+	d.Set("line", line)
+	d.Set("weight", n.Weight)
+	d.Set("geo_location", flattenDNSRecordSetGeoLocation(n.GeoLocation))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, id, _, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var updateOpts recordsets.UpdateOpts
+
+	if d.HasChange("ttl") {
+		updateOpts.TTL = d.Get("ttl").(int)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+	if d.HasChange("weight") {
+		updateOpts.Weight = d.Get("weight").(int)
+	}
+	if d.HasChange("geo_location") {
+		updateOpts.GeoLocation = expandDNSRecordSetGeoLocation(d.Get("geo_location").([]interface{}))
+	}
+	if d.HasChange("record") {
+		records := make([]string, len(d.Get("record").([]interface{})))
+		for i, v := range d.Get("record").([]interface{}) {
+			records[i] = v.(string)
+		}
+		updateOpts.Records = records
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = recordsets.Update(dnsClient, zoneID, id, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud DNS record set: %s", err)
+	}
+
+	return resourceDNSRecordSetV2Read(d, meta)
+}
+
+func resourceDNSRecordSetV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, id, _, err := parseRecordSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := recordsets.Delete(dnsClient, zoneID, id).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Error deleting HuaweiCloud DNS record set: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForDNSRecordSetDelete(dnsClient, zoneID, id),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud DNS record set to be deleted: %s", err)
+	}
+
+	return nil
+}
+
+func waitForDNSRecordSetDelete(dnsClient *golangsdk.ServiceClient, zoneID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rs, err := recordsets.Get(dnsClient, zoneID, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return rs, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return rs, rs.Status, nil
+	}
+}
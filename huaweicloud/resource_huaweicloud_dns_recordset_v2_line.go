@@ -0,0 +1,481 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
+)
+
+// dnsBundledResolutionLines are the intelligent-resolution line IDs Huawei
+// DNS ships out of the box. Custom lines (ISP-specific or user-defined
+// views) are allowed on top of this list via the provider's
+// `dns_custom_lines` argument.
+var dnsBundledResolutionLines = []string{
+	"default_view",
+	"Dianxin",
+	"Liantong",
+	"Yidong",
+	"abroad",
+	"educationnet",
+}
+
+// dnsRRSetKeyMarker is embedded in the description of every recordset this
+// resource manages, so Read/Delete can recognize which of the zone's
+// recordsets belong to this (name, type, line, rrset_key) group without
+// relying on a single Huawei-assigned ID. Huawei models weighted round-robin
+// as one recordset per distinct weight, so one resource here can own several
+// of them. The marker is terminated with dnsRRSetKeyTerminator so an empty
+// rrset_key's marker ("tf-rrset-key=;") isn't a substring of every other
+// group's marker ("tf-rrset-key=canary;").
+const dnsRRSetKeyMarker = "tf-rrset-key="
+const dnsRRSetKeyTerminator = ";"
+
+// ResourceDNSRecordSetV2Line manages every weighted record sharing a
+// (name, type, line, rrset_key) identity as a single logical RRSet, as
+// opposed to huaweicloud_dns_recordset_v2 which manages one recordset at a
+// time. This is the resource to reach for when a record needs several
+// differently-weighted values pinned to the same resolution line.
+func ResourceDNSRecordSetV2Line() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSRecordSetV2LineCreate,
+		Read:   resourceDNSRecordSetV2LineRead,
+		Update: resourceDNSRecordSetV2LineUpdate,
+		Delete: resourceDNSRecordSetV2LineDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "PTR", "NS", "CAA",
+				}, false),
+			},
+
+			// line pins this RRSet to an ISP/region resolution line. Validated
+			// against dnsBundledResolutionLines plus the provider's
+			// dns_custom_lines override in resourceDNSRecordSetV2LineCreate,
+			// since ValidateFunc has no access to provider config.
+			"line": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// rrset_key disambiguates multiple huaweicloud_dns_recordset_v2_line
+			// resources that would otherwise collide on the same
+			// (zone_id, name, type, line) identity, e.g. a canary group kept
+			// independent from the stable group. It can't itself contain
+			// dnsRRSetKeyTerminator, since that delimiter is what keeps one
+			// key's marker from matching as a substring of another's.
+			"rrset_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validateDNSRRSetKey,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandStringList(raw []interface{}) []string {
+	list := make([]string, len(raw))
+	for i, v := range raw {
+		list[i] = v.(string)
+	}
+	return list
+}
+
+type dnsWeightedRecord struct {
+	Value  string
+	Weight int
+}
+
+func expandDNSWeightedRecords(raw *schema.Set) []dnsWeightedRecord {
+	records := make([]dnsWeightedRecord, 0, raw.Len())
+	for _, v := range raw.List() {
+		m := v.(map[string]interface{})
+		records = append(records, dnsWeightedRecord{
+			Value:  m["value"].(string),
+			Weight: m["weight"].(int),
+		})
+	}
+	return records
+}
+
+// groupDNSWeightedRecordsByWeight buckets records by weight, since a single
+// Huawei DNS recordset carries one weight for all of its Records.
+func groupDNSWeightedRecordsByWeight(records []dnsWeightedRecord) map[int][]string {
+	groups := make(map[int][]string)
+	for _, r := range records {
+		groups[r.Weight] = append(groups[r.Weight], r.Value)
+	}
+	return groups
+}
+
+func validateDNSLine(line string, custom []string) error {
+	for _, l := range dnsBundledResolutionLines {
+		if l == line {
+			return nil
+		}
+	}
+	for _, l := range custom {
+		if l == line {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown resolution line %q: must be one of the bundled lines (%s) "+
+		"or listed in the provider's dns_custom_lines", line, strings.Join(dnsBundledResolutionLines, ", "))
+}
+
+// validateDNSRRSetKey rejects dnsRRSetKeyTerminator in rrset_key: allowing
+// it through would let one key's marker match as a substring of another's,
+// e.g. rrset_key "foo" colliding with rrset_key "foo;bar" in
+// dnsDescriptionMatchesRRSetKey.
+func validateDNSRRSetKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.Contains(value, dnsRRSetKeyTerminator) {
+		errors = append(errors, fmt.Errorf(
+			"%q must not contain %q, got: %s", k, dnsRRSetKeyTerminator, value))
+	}
+	return
+}
+
+func dnsRRSetMarker(rrsetKey string) string {
+	return dnsRRSetKeyMarker + rrsetKey + dnsRRSetKeyTerminator
+}
+
+// dnsDescriptionWithMarker packs the rrset_key marker alongside the
+// user-supplied description, since Huawei's recordset API has no field of
+// its own to tag records with a Terraform-side group identity.
+func dnsDescriptionWithMarker(description, rrsetKey string) string {
+	marker := dnsRRSetMarker(rrsetKey)
+	if description == "" {
+		return marker
+	}
+	return description + " " + marker
+}
+
+func dnsDescriptionMatchesRRSetKey(description, rrsetKey string) bool {
+	return strings.Contains(description, dnsRRSetMarker(rrsetKey))
+}
+
+func stripDNSRRSetMarker(description string) string {
+	idx := strings.Index(description, dnsRRSetKeyMarker)
+	if idx < 0 {
+		return description
+	}
+	return strings.TrimSpace(description[:idx])
+}
+
+func dnsLineRRSetID(zoneID, name, rrType, line, rrsetKey string) string {
+	return strings.Join([]string{zoneID, name, rrType, line, rrsetKey}, "/")
+}
+
+func parseDNSLineRRSetID(id string) (zoneID, name, rrType, line, rrsetKey string, err error) {
+	parts := strings.SplitN(id, "/", 5)
+	if len(parts) != 5 {
+		return "", "", "", "", "", fmt.Errorf("invalid huaweicloud_dns_recordset_v2_line id: %s", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], parts[4], nil
+}
+
+// listDNSLineRecordSets returns the recordsets belonging to this resource's
+// (name, type, line, rrset_key) group, by listing every recordset under
+// (zone_id, name, type) and grouping by line, then filtering by the
+// rrset_key marker stashed in the description.
+func listDNSLineRecordSets(client *golangsdk.ServiceClient, zoneID, name, rrType, line, rrsetKey string) ([]recordsets.RecordSet, error) {
+	allPages, err := recordsets.List(client, zoneID, recordsets.ListOpts{
+		Name: name,
+		Type: rrType,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]recordsets.RecordSet, 0, len(all))
+	for _, rs := range all {
+		if rs.Line != line {
+			continue
+		}
+		if !dnsDescriptionMatchesRRSetKey(rs.Description, rrsetKey) {
+			continue
+		}
+		matched = append(matched, rs)
+	}
+	return matched, nil
+}
+
+func resourceDNSRecordSetV2LineCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	line := d.Get("line").(string)
+	if err := validateDNSLine(line, config.CustomDNSLines); err != nil {
+		return err
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	name := d.Get("name").(string)
+	rrType := d.Get("type").(string)
+	rrsetKey := d.Get("rrset_key").(string)
+	description := dnsDescriptionWithMarker(d.Get("description").(string), rrsetKey)
+
+	groups := groupDNSWeightedRecordsByWeight(expandDNSWeightedRecords(d.Get("record").(*schema.Set)))
+	for weight, records := range groups {
+		createOpts := recordsets.CreateOpts{
+			Name:        name,
+			Type:        rrType,
+			TTL:         d.Get("ttl").(int),
+			Description: description,
+			Records:     records,
+			Line:        line,
+			Weight:      weight,
+		}
+		log.Printf("[DEBUG] Create Options: %#v", createOpts)
+		if _, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract(); err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud DNS record set: %s", err)
+		}
+	}
+
+	d.SetId(dnsLineRRSetID(zoneID, name, rrType, line, rrsetKey))
+
+	log.Printf("[DEBUG] Created HuaweiCloud DNS record set line group %s", d.Id())
+	return resourceDNSRecordSetV2LineRead(d, meta)
+}
+
+func resourceDNSRecordSetV2LineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, name, rrType, line, rrsetKey, err := parseDNSLineRRSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	matched, err := listDNSLineRecordSets(dnsClient, zoneID, name, rrType, line, rrsetKey)
+	if err != nil {
+		return fmt.Errorf("Error reading HuaweiCloud DNS record set line group: %s", err)
+	}
+
+	if len(matched) == 0 {
+		log.Printf("[WARN] HuaweiCloud DNS record set line group %s no longer exists", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	records := make([]map[string]interface{}, 0)
+	for _, rs := range matched {
+		for _, value := range rs.Records {
+			records = append(records, map[string]interface{}{
+				"value":  value,
+				"weight": rs.Weight,
+			})
+		}
+	}
+
+	d.Set("region", GetRegion(d, config))
+	d.Set("zone_id", zoneID)
+	d.Set("name", name)
+	d.Set("type", rrType)
+	d.Set("line", line)
+	d.Set("rrset_key", rrsetKey)
+	d.Set("ttl", matched[0].TTL)
+	d.Set("description", stripDNSRRSetMarker(matched[0].Description))
+	d.Set("record", records)
+
+	return nil
+}
+
+// dnsStringSlicesEqualUnordered reports whether a and b hold the same
+// values, ignoring order, so a weight's recordset isn't recreated just
+// because its records were listed in a different order than before.
+func dnsStringSlicesEqualUnordered(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceDNSRecordSetV2LineUpdate diffs the desired weight groups against
+// the existing recordsets instead of deleting the whole group and
+// recreating it: only weights whose records actually changed are
+// Update()d, weights that disappeared are deleted, and only brand new
+// weights are created. This closes the window where the whole rrset briefly
+// has zero records on every apply.
+func resourceDNSRecordSetV2LineUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, name, rrType, line, rrsetKey, err := parseDNSLineRRSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := listDNSLineRecordSets(dnsClient, zoneID, name, rrType, line, rrsetKey)
+	if err != nil {
+		return fmt.Errorf("Error reading HuaweiCloud DNS record set line group: %s", err)
+	}
+	existingByWeight := make(map[int]recordsets.RecordSet, len(existing))
+	for _, rs := range existing {
+		existingByWeight[rs.Weight] = rs
+	}
+
+	ttl := d.Get("ttl").(int)
+	description := dnsDescriptionWithMarker(d.Get("description").(string), rrsetKey)
+	groups := groupDNSWeightedRecordsByWeight(expandDNSWeightedRecords(d.Get("record").(*schema.Set)))
+
+	for weight, records := range groups {
+		rs, ok := existingByWeight[weight]
+		if !ok {
+			createOpts := recordsets.CreateOpts{
+				Name:        name,
+				Type:        rrType,
+				TTL:         ttl,
+				Description: description,
+				Records:     records,
+				Line:        line,
+				Weight:      weight,
+			}
+			if _, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract(); err != nil {
+				return fmt.Errorf("Error updating HuaweiCloud DNS record set line group: %s", err)
+			}
+			continue
+		}
+
+		if rs.TTL == ttl && rs.Description == description && dnsStringSlicesEqualUnordered(rs.Records, records) {
+			continue
+		}
+
+		updateOpts := recordsets.UpdateOpts{
+			TTL:         ttl,
+			Description: description,
+			Records:     records,
+		}
+		if _, err := recordsets.Update(dnsClient, zoneID, rs.ID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud DNS record set line group: %s", err)
+		}
+	}
+
+	for weight, rs := range existingByWeight {
+		if _, ok := groups[weight]; ok {
+			continue
+		}
+		if err := recordsets.Delete(dnsClient, zoneID, rs.ID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("Error updating HuaweiCloud DNS record set line group: %s", err)
+			}
+		}
+	}
+
+	return resourceDNSRecordSetV2LineRead(d, meta)
+}
+
+func resourceDNSRecordSetV2LineDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneID, name, rrType, line, rrsetKey, err := parseDNSLineRRSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	matched, err := listDNSLineRecordSets(dnsClient, zoneID, name, rrType, line, rrsetKey)
+	if err != nil {
+		return fmt.Errorf("Error reading HuaweiCloud DNS record set line group: %s", err)
+	}
+
+	for _, rs := range matched {
+		if err := recordsets.Delete(dnsClient, zoneID, rs.ID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("Error deleting HuaweiCloud DNS record set line group: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
+)
+
+// TestAccDNSRecordSetV2Line_rrsetKeyGroups creates two
+// huaweicloud_dns_recordset_v2_line resources on the same
+// (zone_id, name, type, line) with distinct rrset_key values, so each
+// group's Read must only pick up its own recordsets via the description
+// marker instead of bleeding into the other group.
+func TestAccDNSRecordSetV2Line_rrsetKeyGroups(t *testing.T) {
+	zoneName := fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSRecordSetV2LineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSRecordSetV2Line_rrsetKeyGroups(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"huaweicloud_dns_recordset_v2_line.stable", "rrset_key", "stable"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_dns_recordset_v2_line.stable", "record.#", "1"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_dns_recordset_v2_line.canary", "rrset_key", "canary"),
+					resource.TestCheckResourceAttr(
+						"huaweicloud_dns_recordset_v2_line.canary", "record.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccDNSRecordSetV2Line_updateInPlace bumps ttl on a single-weight
+// rrset and checks the underlying recordset's ID is unchanged, proving
+// Update diffs against the existing weight group and calls
+// recordsets.Update() instead of deleting and recreating it.
+func TestAccDNSRecordSetV2Line_updateInPlace(t *testing.T) {
+	var before, after recordsets.RecordSet
+	zoneName := fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+	resourceName := "huaweicloud_dns_recordset_v2_line.stable"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSRecordSetV2LineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSRecordSetV2Line_ttl(zoneName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSRecordSetV2LineExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+				),
+			},
+			{
+				Config: testAccDNSRecordSetV2Line_ttl(zoneName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSRecordSetV2LineExists(resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "600"),
+					func(s *terraform.State) error {
+						if before.ID != after.ID {
+							return fmt.Errorf("expected the underlying recordset to be updated in place, "+
+								"got a new ID: %s -> %s", before.ID, after.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSRecordSetV2LineExists(n string, rs *recordsets.RecordSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+		}
+
+		zoneID, name, rrType, line, rrsetKey, err := parseDNSLineRRSetID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		matched, err := listDNSLineRecordSets(dnsClient, zoneID, name, rrType, line, rrsetKey)
+		if err != nil {
+			return err
+		}
+		if len(matched) != 1 {
+			return fmt.Errorf("expected exactly 1 recordset for %s, got %d", n, len(matched))
+		}
+
+		*rs = matched[0]
+		return nil
+	}
+}
+
+func testAccCheckDNSRecordSetV2LineDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "huaweicloud_dns_recordset_v2_line" {
+			continue
+		}
+
+		zoneID, name, rrType, line, rrsetKey, err := parseDNSLineRRSetID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		matched, err := listDNSLineRecordSets(dnsClient, zoneID, name, rrType, line, rrsetKey)
+		if err != nil {
+			return err
+		}
+		if len(matched) != 0 {
+			return fmt.Errorf("DNS record set line group still exists: %s", r.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSRecordSetV2Line_rrsetKeyGroups(zoneName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "zone_1" {
+  name  = "%s"
+  email = "email@example.com"
+}
+
+resource "huaweicloud_dns_recordset_v2_line" "stable" {
+  zone_id   = huaweicloud_dns_zone.zone_1.id
+  name      = "www.%s"
+  type      = "A"
+  line      = "Dianxin"
+  rrset_key = "stable"
+
+  record {
+    value  = "10.1.0.1"
+    weight = 100
+  }
+}
+
+resource "huaweicloud_dns_recordset_v2_line" "canary" {
+  zone_id   = huaweicloud_dns_zone.zone_1.id
+  name      = "www.%s"
+  type      = "A"
+  line      = "Dianxin"
+  rrset_key = "canary"
+
+  record {
+    value  = "10.1.0.2"
+    weight = 100
+  }
+}
+	`, zoneName, zoneName, zoneName)
+}
+
+func testAccDNSRecordSetV2Line_ttl(zoneName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "zone_1" {
+  name  = "%s"
+  email = "email@example.com"
+}
+
+resource "huaweicloud_dns_recordset_v2_line" "stable" {
+  zone_id   = huaweicloud_dns_zone.zone_1.id
+  name      = "www.%s"
+  type      = "A"
+  line      = "Dianxin"
+  rrset_key = "stable"
+  ttl       = %d
+
+  record {
+    value  = "10.1.0.1"
+    weight = 100
+  }
+}
+	`, zoneName, zoneName, ttl)
+}
@@ -0,0 +1,161 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/recordsets"
+)
+
+func TestAccDNSV2RecordSet_weighted(t *testing.T) {
+	var rs recordsets.RecordSet
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+	resourceName := "huaweicloud_dns_recordset.rs_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_weighted(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists(resourceName, &rs),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "line", "Dianxin"),
+					resource.TestCheckResourceAttr(resourceName, "weight", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDNSV2RecordSet_geoLocation(t *testing.T) {
+	var rs recordsets.RecordSet
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+	resourceName := "huaweicloud_dns_recordset.rs_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_geoLocation(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists(resourceName, &rs),
+					resource.TestCheckResourceAttr(resourceName, "type", "CNAME"),
+					resource.TestCheckResourceAttr(resourceName, "geo_location.0.country", "CN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.DnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "huaweicloud_dns_recordset" {
+			continue
+		}
+
+		zoneID, id, _, err := parseRecordSetID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = recordsets.Get(dnsClient, zoneID, id).Extract()
+		if err == nil {
+			return fmt.Errorf("Record set still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2RecordSetExists(n string, rs *recordsets.RecordSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if r.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.DnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+		}
+
+		zoneID, id, _, err := parseRecordSetID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := recordsets.Get(dnsClient, zoneID, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != id {
+			return fmt.Errorf("Record set not found")
+		}
+
+		*rs = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2RecordSet_weighted(zoneName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "zone_1" {
+  name  = "%s"
+  email = "email@example.com"
+}
+
+resource "huaweicloud_dns_recordset" "rs_1" {
+  zone_id = huaweicloud_dns_zone.zone_1.id
+  name    = "www.%s"
+  type    = "A"
+  ttl     = 300
+  line    = "Dianxin"
+  weight  = 30
+  record  = ["10.1.0.1"]
+}
+	`, zoneName, zoneName)
+}
+
+func testAccDNSV2RecordSet_geoLocation(zoneName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_zone" "zone_1" {
+  name  = "%s"
+  email = "email@example.com"
+}
+
+resource "huaweicloud_dns_recordset" "rs_1" {
+  zone_id = huaweicloud_dns_zone.zone_1.id
+  name    = "geo.%s"
+  type    = "CNAME"
+  ttl     = 300
+  record  = ["target.example.com."]
+
+  geo_location {
+    continent = "AS"
+    country   = "CN"
+  }
+}
+	`, zoneName, zoneName)
+}
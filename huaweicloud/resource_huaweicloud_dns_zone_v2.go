@@ -0,0 +1,372 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/zones"
+)
+
+func ResourceDNSZoneV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSZoneV2Create,
+		Read:   resourceDNSZoneV2Read,
+		Update: resourceDNSZoneV2Update,
+		Delete: resourceDNSZoneV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "public",
+				ValidateFunc: validation.StringInSlice([]string{
+					"public", "private",
+				}, false),
+			},
+
+			// router is kept repeatable so that a private zone can be shared
+			// across many VPCs, optionally in different regions. Each entry is
+			// independently associated/disassociated by (router_id, router_region)
+			// rather than forcing a recreate of the zone.
+			"router": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"router_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"masters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+type zoneRouter struct {
+	RouterID     string
+	RouterRegion string
+}
+
+func expandDNSZoneRouters(routerSet *schema.Set) []zoneRouter {
+	routers := make([]zoneRouter, 0, routerSet.Len())
+	for _, raw := range routerSet.List() {
+		v := raw.(map[string]interface{})
+		routers = append(routers, zoneRouter{
+			RouterID:     v["router_id"].(string),
+			RouterRegion: v["router_region"].(string),
+		})
+	}
+	return routers
+}
+
+func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	zoneType := d.Get("zone_type").(string)
+
+	createOpts := zones.CreateOpts{
+		Name:        d.Get("name").(string),
+		Email:       d.Get("email").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+		ZoneType:    zoneType,
+	}
+
+	routers := expandDNSZoneRouters(d.Get("router").(*schema.Set))
+	if zoneType == "private" && len(routers) > 0 {
+		createOpts.Router = zones.RouterOpts{
+			RouterID:     routers[0].RouterID,
+			RouterRegion: routers[0].RouterRegion,
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	n, err := zones.Create(dnsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS zone: %s", err)
+	}
+
+	d.SetId(n.ID)
+
+	// the first router was consumed by the create call; associate the rest.
+	if zoneType == "private" && len(routers) > 1 {
+		for _, r := range routers[1:] {
+			if err := associateDNSZoneRouter(dnsClient, n.ID, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Created HuaweiCloud DNS zone %s: %#v", n.ID, n)
+	return resourceDNSZoneV2Read(d, meta)
+}
+
+func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	n, err := zones.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "zone")
+	}
+
+	d.Set("name", n.Name)
+	d.Set("email", n.Email)
+	d.Set("description", n.Description)
+	d.Set("ttl", n.TTL)
+	d.Set("zone_type", n.ZoneType)
+	d.Set("status", n.Status)
+	d.Set("masters", n.Masters)
+	d.Set("region", GetRegion(d, config))
+
+	routers, err := listDNSZoneRouters(dnsClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading router associations for HuaweiCloud DNS zone %s: %s", d.Id(), err)
+	}
+	routerList := make([]map[string]interface{}, len(routers))
+	for i, r := range routers {
+		routerList[i] = map[string]interface{}{
+			"router_id":     r.RouterID,
+			"router_region": r.RouterRegion,
+		}
+	}
+	d.Set("router", routerList)
+
+	return nil
+}
+
+func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	var updateOpts zones.UpdateOpts
+	changed := false
+
+	if d.HasChange("email") {
+		updateOpts.Email = d.Get("email").(string)
+		changed = true
+	}
+	if d.HasChange("ttl") {
+		updateOpts.TTL = d.Get("ttl").(int)
+		changed = true
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+		changed = true
+	}
+
+	if changed {
+		_, err = zones.Update(dnsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud DNS zone: %s", err)
+		}
+	}
+
+	if d.HasChange("router") {
+		o, n := d.GetChange("router")
+		oldRouters := expandDNSZoneRouters(o.(*schema.Set))
+		newRouters := expandDNSZoneRouters(n.(*schema.Set))
+
+		for _, r := range oldRouters {
+			if !routerInList(r, newRouters) {
+				if err := disassociateDNSZoneRouter(dnsClient, d.Id(), r); err != nil {
+					return err
+				}
+			}
+		}
+		for _, r := range newRouters {
+			if !routerInList(r, oldRouters) {
+				if err := associateDNSZoneRouter(dnsClient, d.Id(), r); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return resourceDNSZoneV2Read(d, meta)
+}
+
+func resourceDNSZoneV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud DNS client: %s", err)
+	}
+
+	// disassociate any extra routers first so the final delete only has to
+	// tear down the primary (creation-time) association, if any.
+	routers, err := listDNSZoneRouters(dnsClient, d.Id())
+	if err == nil && len(routers) > 1 {
+		for _, r := range routers[1:] {
+			if err := disassociateDNSZoneRouter(dnsClient, d.Id(), r); err != nil {
+				log.Printf("[WARN] Error disassociating router %s from zone %s: %s", r.RouterID, d.Id(), err)
+			}
+		}
+	}
+
+	if err := zones.Delete(dnsClient, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud DNS zone: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Target:     []string{"DELETED"},
+		Refresh:    waitForDNSZoneDelete(dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud DNS zone to be deleted: %s", err)
+	}
+
+	return nil
+}
+
+func waitForDNSZoneDelete(dnsClient *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		zone, err := zones.Get(dnsClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return zone, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return zone, zone.Status, nil
+	}
+}
+
+func routerInList(r zoneRouter, list []zoneRouter) bool {
+	for _, item := range list {
+		if item.RouterID == r.RouterID && item.RouterRegion == r.RouterRegion {
+			return true
+		}
+	}
+	return false
+}
+
+func associateDNSZoneRouter(dnsClient *golangsdk.ServiceClient, zoneID string, r zoneRouter) error {
+	opts := zones.RouterOpts{
+		RouterID:     r.RouterID,
+		RouterRegion: r.RouterRegion,
+	}
+	log.Printf("[DEBUG] Associating router %#v with DNS zone %s", opts, zoneID)
+	_, err := zones.AssociateRouter(dnsClient, zoneID, opts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error associating router %s with HuaweiCloud DNS zone %s: %s", r.RouterID, zoneID, err)
+	}
+	return nil
+}
+
+func disassociateDNSZoneRouter(dnsClient *golangsdk.ServiceClient, zoneID string, r zoneRouter) error {
+	opts := zones.RouterOpts{
+		RouterID:     r.RouterID,
+		RouterRegion: r.RouterRegion,
+	}
+	log.Printf("[DEBUG] Disassociating router %#v from DNS zone %s", opts, zoneID)
+	err := zones.DisassociateRouter(dnsClient, zoneID, opts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error disassociating router %s from HuaweiCloud DNS zone %s: %s", r.RouterID, zoneID, err)
+	}
+	return nil
+}
+
+func listDNSZoneRouters(dnsClient *golangsdk.ServiceClient, zoneID string) ([]zoneRouter, error) {
+	n, err := zones.Get(dnsClient, zoneID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	routers := make([]zoneRouter, 0, len(n.Routers))
+	for _, r := range n.Routers {
+		routers = append(routers, zoneRouter{
+			RouterID:     r.RouterID,
+			RouterRegion: r.RouterRegion,
+		})
+	}
+	return routers, nil
+}
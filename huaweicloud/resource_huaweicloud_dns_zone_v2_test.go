@@ -75,6 +75,41 @@ func TestAccDNSV2Zone_private(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2Zone_privateMultiRouter(t *testing.T) {
+	var zone zones.Zone
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+	resourceName := "huaweicloud_dns_zone.zone_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2Zone_private(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "router.#", "1"),
+				),
+			},
+			{
+				Config: testAccDNSV2Zone_privateMultiRouter(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "router.#", "2"),
+				),
+			},
+			{
+				Config: testAccDNSV2Zone_private(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "router.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDNSV2Zone_readTTL(t *testing.T) {
 	var zone zones.Zone
 	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
@@ -198,3 +233,31 @@ resource "huaweicloud_dns_zone" "zone_1" {
 }
 	`, zoneName)
 }
+
+func testAccDNSV2Zone_privateMultiRouter(zoneName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_vpc" "default" {
+  name = "vpc-default"
+}
+
+resource "huaweicloud_vpc" "vpc_2" {
+  name = "vpc_dns_zone_2"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_dns_zone" "zone_1" {
+  name        = "%s"
+  email       = "email@example.com"
+  description = "a private zone"
+  zone_type   = "private"
+
+  router {
+    router_id = data.huaweicloud_vpc.default.id
+  }
+
+  router {
+    router_id = huaweicloud_vpc.vpc_2.id
+  }
+}
+	`, zoneName)
+}
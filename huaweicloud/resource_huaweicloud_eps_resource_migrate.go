@@ -0,0 +1,146 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/eps/v1/migrate"
+)
+
+// resourceEpsResourceMigrateV1 moves an existing resource (ECS, EVS, RDS,
+// VPC, ELB, CCE, ...) into a different enterprise project by calling the EPS
+// migrate-resource API and waiting for the resulting async job, so a user
+// can Terraform-manage EPS assignment without recreating the resource
+// itself. This is an action resource: there is nothing to read back beyond
+// the job outcome, and Delete only forgets the resource from state.
+func resourceEpsResourceMigrateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEpsResourceMigrateV1Create,
+		Read:   resourceEpsResourceMigrateV1Read,
+		Update: resourceEpsResourceMigrateV1Update,
+		Delete: resourceEpsResourceMigrateV1Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_enterprise_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceEpsResourceMigrateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.EpsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud EPS client: %s", err)
+	}
+
+	resourceID := d.Get("resource_id").(string)
+	if err := epsMigrateResourceAndWait(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(resourceID)
+	return resourceEpsResourceMigrateV1Read(d, meta)
+}
+
+func resourceEpsResourceMigrateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	d.Set("region", GetRegion(d, config))
+	return nil
+}
+
+func resourceEpsResourceMigrateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.EpsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud EPS client: %s", err)
+	}
+
+	if d.HasChange("target_enterprise_project_id") {
+		if err := epsMigrateResourceAndWait(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceEpsResourceMigrateV1Read(d, meta)
+}
+
+func resourceEpsResourceMigrateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	// Migrating is a one-way action against the target resource; removing
+	// this resource only drops it from state, it does not migrate anything back.
+	return nil
+}
+
+// epsMigrateResourceAndWait submits the migrate-resource request and blocks
+// until its async job reaches a terminal state. timeout is the caller's own
+// Create/Update timeout, since a migration triggered from Update should
+// respect a user-configured `timeouts { update = ... }` rather than always
+// waiting on the Create timeout.
+func epsMigrateResourceAndWait(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	createOpts := migrate.CreateOpts{
+		ResourceType:        d.Get("resource_type").(string),
+		ResourceID:          d.Get("resource_id").(string),
+		EnterpriseProjectID: d.Get("target_enterprise_project_id").(string),
+	}
+
+	job, err := migrate.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error migrating HuaweiCloud resource %s to enterprise project %s: %s",
+			createOpts.ResourceID, createOpts.EnterpriseProjectID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RUNNING", "INIT", "WAITING"},
+		Target:     []string{"SUCCESS"},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			status, err := migrate.GetJob(client, job.JobID).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			if status.Status == "FAIL" {
+				return status, "", fmt.Errorf("EPS migration job %s failed: %s", job.JobID, status.FailReason)
+			}
+			return status, status.Status, nil
+		},
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EPS migration job %s to complete: %s", job.JobID, err)
+	}
+
+	return nil
+}
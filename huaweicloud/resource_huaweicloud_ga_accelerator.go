@@ -0,0 +1,254 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/common/tags"
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/accelerators"
+)
+
+// resourceGaAcceleratorV1 manages a Global Accelerator instance. GA is a
+// global service, so unlike most resources here it talks to
+// config.GaV1Client() instead of a region-scoped client.
+func resourceGaAcceleratorV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaAcceleratorV1Create,
+		Read:   resourceGaAcceleratorV1Read,
+		Update: resourceGaAcceleratorV1Update,
+		Delete: resourceGaAcceleratorV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"ip_sets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"area": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"frozen_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"", "POLICE", "ILLEGAL", "ARREAR", "VERIFY",
+				}, false),
+			},
+
+			"tags": TagsSchema(),
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaAcceleratorV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := accelerators.CreateOpts{
+		Name:        d.Get("name").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Description: d.Get("description").(string),
+		IPSets:      expandGaAcceleratorIPSets(d.Get("ip_sets").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	accelerator, err := accelerators.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA accelerator: %s", err)
+	}
+
+	d.SetId(accelerator.ID)
+
+	if err := waitForGaAcceleratorActive(client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		if err := UpdateResourceTags(client, d, "accelerator", d.Id()); err != nil {
+			return fmt.Errorf("Error setting tags on HuaweiCloud GA accelerator %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGaAcceleratorV1Read(d, meta)
+}
+
+func resourceGaAcceleratorV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	accelerator, err := accelerators.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "accelerator")
+	}
+
+	d.Set("name", accelerator.Name)
+	d.Set("enabled", accelerator.Enabled)
+	d.Set("description", accelerator.Description)
+	d.Set("frozen_reason", accelerator.FrozenReason)
+	d.Set("status", accelerator.Status)
+	d.Set("ip_address", accelerator.IPAddress)
+	d.Set("ip_sets", flattenGaAcceleratorIPSets(accelerator.IPSets))
+
+	resourceTags, err := tags.ShowResourceTags(client, "accelerator", d.Id())
+	if err != nil {
+		return fmt.Errorf("Error fetching tags for HuaweiCloud GA accelerator %s: %s", d.Id(), err)
+	}
+	d.Set("tags", TagsToMap(resourceTags))
+
+	return nil
+}
+
+func resourceGaAcceleratorV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChanges("name", "enabled", "description", "frozen_reason") {
+		updateOpts := accelerators.UpdateOpts{
+			Name:         d.Get("name").(string),
+			Enabled:      d.Get("enabled").(bool),
+			Description:  d.Get("description").(string),
+			FrozenReason: d.Get("frozen_reason").(string),
+		}
+		if _, err := accelerators.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA accelerator: %s", err)
+		}
+		if err := waitForGaAcceleratorActive(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := UpdateResourceTags(client, d, "accelerator", d.Id()); err != nil {
+			return fmt.Errorf("Error updating tags on HuaweiCloud GA accelerator %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGaAcceleratorV1Read(d, meta)
+}
+
+func resourceGaAcceleratorV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if err := accelerators.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA accelerator: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    gaAcceleratorStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func expandGaAcceleratorIPSets(raw []interface{}) []accelerators.IPSet {
+	sets := make([]accelerators.IPSet, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		sets[i] = accelerators.IPSet{Area: m["area"].(string)}
+	}
+	return sets
+}
+
+func flattenGaAcceleratorIPSets(ipSets []accelerators.IPSet) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(ipSets))
+	for i, s := range ipSets {
+		raw[i] = map[string]interface{}{
+			"area": s.Area,
+		}
+	}
+	return raw
+}
+
+func waitForGaAcceleratorActive(client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    gaAcceleratorStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud GA accelerator %s to become ACTIVE: %s", id, err)
+	}
+	return nil
+}
+
+func gaAcceleratorStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accelerator, err := accelerators.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return accelerator, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return accelerator, accelerator.Status, nil
+	}
+}
@@ -0,0 +1,126 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/accelerators"
+)
+
+func TestAccGaAccelerator_basic(t *testing.T) {
+	var accelerator accelerators.Accelerator
+	rName := fmt.Sprintf("acpttest-ga-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_ga_accelerator.accelerator_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGaAcceleratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaAccelerator_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGaAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "terraform"),
+				),
+			},
+			{
+				Config: testAccGaAccelerator_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGaAcceleratorExists(resourceName, &accelerator),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGaAcceleratorDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_ga_accelerator" {
+			continue
+		}
+
+		_, err := accelerators.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("GA accelerator still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckGaAcceleratorExists(n string, accelerator *accelerators.Accelerator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.GaV1Client()
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+		}
+
+		found, err := accelerators.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("GA accelerator not found")
+		}
+
+		*accelerator = *found
+
+		return nil
+	}
+}
+
+func testAccGaAccelerator_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_ga_accelerator" "accelerator_1" {
+  name = "%s"
+
+  tags = {
+    owner = "terraform"
+  }
+}
+`, rName)
+}
+
+func testAccGaAccelerator_update(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_ga_accelerator" "accelerator_1" {
+  name    = "%s-updated"
+  enabled = false
+
+  tags = {
+    owner = "terraform"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,143 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/endpoints"
+)
+
+func resourceGaEndpointV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaEndpointV1Create,
+		Read:   resourceGaEndpointV1Read,
+		Update: resourceGaEndpointV1Update,
+		Delete: resourceGaEndpointV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"weight": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaEndpointV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := endpoints.CreateOpts{
+		EndpointGroupID: d.Get("endpoint_group_id").(string),
+		ResourceID:      d.Get("resource_id").(string),
+		ResourceType:    d.Get("resource_type").(string),
+		Weight:          d.Get("weight").(int),
+	}
+
+	endpoint, err := endpoints.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA endpoint: %s", err)
+	}
+
+	d.SetId(endpoint.ID)
+
+	return resourceGaEndpointV1Read(d, meta)
+}
+
+func resourceGaEndpointV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	endpoint, err := endpoints.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "endpoint")
+	}
+
+	d.Set("endpoint_group_id", endpoint.EndpointGroupID)
+	d.Set("resource_id", endpoint.ResourceID)
+	d.Set("resource_type", endpoint.ResourceType)
+	d.Set("weight", endpoint.Weight)
+	d.Set("ip_address", endpoint.IPAddress)
+	d.Set("status", endpoint.Status)
+
+	return nil
+}
+
+func resourceGaEndpointV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChange("weight") {
+		updateOpts := endpoints.UpdateOpts{
+			Weight: d.Get("weight").(int),
+		}
+		if _, err := endpoints.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA endpoint: %s", err)
+		}
+	}
+
+	return resourceGaEndpointV1Read(d, meta)
+}
+
+func resourceGaEndpointV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if err := endpoints.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA endpoint: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/endpointgroups"
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/listeners"
+)
+
+func resourceGaEndpointGroupV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaEndpointGroupV1Create,
+		Read:   resourceGaEndpointGroupV1Read,
+		Update: resourceGaEndpointGroupV1Update,
+		Delete: resourceGaEndpointGroupV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaEndpointGroupV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := endpointgroups.CreateOpts{
+		ListenerID:  d.Get("listener_id").(string),
+		Name:        d.Get("name").(string),
+		RegionID:    d.Get("region_id").(string),
+		Description: d.Get("description").(string),
+	}
+
+	group, err := endpointgroups.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA endpoint group: %s", err)
+	}
+
+	d.SetId(group.ID)
+
+	acceleratorID, err := gaEndpointGroupParentAccelerator(client, createOpts.ListenerID)
+	if err != nil {
+		return err
+	}
+	if err := waitForGaAcceleratorActive(client, acceleratorID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceGaEndpointGroupV1Read(d, meta)
+}
+
+// gaEndpointGroupParentAccelerator resolves the accelerator that owns an
+// endpoint group's listener, so callers can wait for it to settle back into
+// ACTIVE the same way resourceGaListenerV1 does.
+func gaEndpointGroupParentAccelerator(client *golangsdk.ServiceClient, listenerID string) (string, error) {
+	listener, err := listeners.Get(client, listenerID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving HuaweiCloud GA listener %s: %s", listenerID, err)
+	}
+	return listener.AcceleratorID, nil
+}
+
+func resourceGaEndpointGroupV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	group, err := endpointgroups.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "endpoint group")
+	}
+
+	d.Set("listener_id", group.ListenerID)
+	d.Set("name", group.Name)
+	d.Set("region_id", group.RegionID)
+	d.Set("description", group.Description)
+	d.Set("status", group.Status)
+
+	return nil
+}
+
+func resourceGaEndpointGroupV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updateOpts := endpointgroups.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if _, err := endpointgroups.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA endpoint group: %s", err)
+		}
+
+		acceleratorID, err := gaEndpointGroupParentAccelerator(client, d.Get("listener_id").(string))
+		if err != nil {
+			return err
+		}
+		if err := waitForGaAcceleratorActive(client, acceleratorID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGaEndpointGroupV1Read(d, meta)
+}
+
+func resourceGaEndpointGroupV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	acceleratorID, err := gaEndpointGroupParentAccelerator(client, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := endpointgroups.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA endpoint group: %s", err)
+	}
+
+	return waitForGaAcceleratorActive(client, acceleratorID, d.Timeout(schema.TimeoutDelete))
+}
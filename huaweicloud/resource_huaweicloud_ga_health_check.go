@@ -0,0 +1,159 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/healthchecks"
+)
+
+func resourceGaHealthCheckV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaHealthCheckV1Create,
+		Read:   resourceGaHealthCheckV1Read,
+		Update: resourceGaHealthCheckV1Update,
+		Delete: resourceGaHealthCheckV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "HTTP", "HTTPS",
+				}, false),
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"interval": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  10,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaHealthCheckV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := healthchecks.CreateOpts{
+		EndpointGroupID: d.Get("endpoint_group_id").(string),
+		Protocol:        d.Get("protocol").(string),
+		Port:            d.Get("port").(int),
+		Interval:        d.Get("interval").(int),
+		Timeout:         d.Get("timeout").(int),
+		MaxRetries:      d.Get("max_retries").(int),
+	}
+
+	check, err := healthchecks.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA health check: %s", err)
+	}
+
+	d.SetId(check.ID)
+
+	return resourceGaHealthCheckV1Read(d, meta)
+}
+
+func resourceGaHealthCheckV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	check, err := healthchecks.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "health check")
+	}
+
+	d.Set("endpoint_group_id", check.EndpointGroupID)
+	d.Set("protocol", check.Protocol)
+	d.Set("port", check.Port)
+	d.Set("interval", check.Interval)
+	d.Set("timeout", check.Timeout)
+	d.Set("max_retries", check.MaxRetries)
+	d.Set("status", check.Status)
+
+	return nil
+}
+
+func resourceGaHealthCheckV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChanges("protocol", "port", "interval", "timeout", "max_retries") {
+		updateOpts := healthchecks.UpdateOpts{
+			Protocol:   d.Get("protocol").(string),
+			Port:       d.Get("port").(int),
+			Interval:   d.Get("interval").(int),
+			Timeout:    d.Get("timeout").(int),
+			MaxRetries: d.Get("max_retries").(int),
+		}
+		if _, err := healthchecks.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA health check: %s", err)
+		}
+	}
+
+	return resourceGaHealthCheckV1Read(d, meta)
+}
+
+func resourceGaHealthCheckV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if err := healthchecks.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA health check: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/ipgroups"
+)
+
+func resourceGaIPGroupV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaIPGroupV1Create,
+		Read:   resourceGaIPGroupV1Read,
+		Update: resourceGaIPGroupV1Update,
+		Delete: resourceGaIPGroupV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGaIPGroupV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := ipgroups.CreateOpts{
+		Name:        d.Get("name").(string),
+		RegionID:    d.Get("region_id").(string),
+		Description: d.Get("description").(string),
+		IPList:      expandGaIPGroupIPList(d.Get("ip_list").([]interface{})),
+	}
+
+	group, err := ipgroups.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA IP group: %s", err)
+	}
+
+	d.SetId(group.ID)
+
+	return resourceGaIPGroupV1Read(d, meta)
+}
+
+func resourceGaIPGroupV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	group, err := ipgroups.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IP group")
+	}
+
+	d.Set("name", group.Name)
+	d.Set("region_id", group.RegionID)
+	d.Set("description", group.Description)
+	d.Set("ip_list", flattenGaIPGroupIPList(group.IPList))
+
+	return nil
+}
+
+func resourceGaIPGroupV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "ip_list") {
+		updateOpts := ipgroups.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			IPList:      expandGaIPGroupIPList(d.Get("ip_list").([]interface{})),
+		}
+		if _, err := ipgroups.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA IP group: %s", err)
+		}
+	}
+
+	return resourceGaIPGroupV1Read(d, meta)
+}
+
+func resourceGaIPGroupV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if err := ipgroups.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA IP group: %s", err)
+	}
+
+	return nil
+}
+
+func expandGaIPGroupIPList(raw []interface{}) []ipgroups.IPEntry {
+	entries := make([]ipgroups.IPEntry, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		entries[i] = ipgroups.IPEntry{
+			CIDR:        m["cidr"].(string),
+			Description: m["description"].(string),
+		}
+	}
+	return entries
+}
+
+func flattenGaIPGroupIPList(entries []ipgroups.IPEntry) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(entries))
+	for i, e := range entries {
+		raw[i] = map[string]interface{}{
+			"cidr":        e.CIDR,
+			"description": e.Description,
+		}
+	}
+	return raw
+}
@@ -0,0 +1,190 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk/openstack/ga/v1/listeners"
+)
+
+func resourceGaListenerV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGaListenerV1Create,
+		Read:   resourceGaListenerV1Read,
+		Update: resourceGaListenerV1Update,
+		Delete: resourceGaListenerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP",
+				}, false),
+			},
+
+			"port_ranges": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"to_port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGaListenerV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	createOpts := listeners.CreateOpts{
+		AcceleratorID: d.Get("accelerator_id").(string),
+		Name:          d.Get("name").(string),
+		Protocol:      d.Get("protocol").(string),
+		Description:   d.Get("description").(string),
+		PortRanges:    expandGaListenerPortRanges(d.Get("port_ranges").([]interface{})),
+	}
+
+	listener, err := listeners.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA listener: %s", err)
+	}
+
+	d.SetId(listener.ID)
+
+	if err := waitForGaAcceleratorActive(client, d.Get("accelerator_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceGaListenerV1Read(d, meta)
+}
+
+func resourceGaListenerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	listener, err := listeners.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "listener")
+	}
+
+	d.Set("accelerator_id", listener.AcceleratorID)
+	d.Set("name", listener.Name)
+	d.Set("protocol", listener.Protocol)
+	d.Set("description", listener.Description)
+	d.Set("status", listener.Status)
+	d.Set("port_ranges", flattenGaListenerPortRanges(listener.PortRanges))
+
+	return nil
+}
+
+func resourceGaListenerV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "port_ranges") {
+		updateOpts := listeners.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+			PortRanges:  expandGaListenerPortRanges(d.Get("port_ranges").([]interface{})),
+		}
+		if _, err := listeners.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud GA listener: %s", err)
+		}
+		if err := waitForGaAcceleratorActive(client, d.Get("accelerator_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGaListenerV1Read(d, meta)
+}
+
+func resourceGaListenerV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.GaV1Client()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud GA client: %s", err)
+	}
+
+	if err := listeners.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud GA listener: %s", err)
+	}
+
+	return waitForGaAcceleratorActive(client, d.Get("accelerator_id").(string), d.Timeout(schema.TimeoutDelete))
+}
+
+func expandGaListenerPortRanges(raw []interface{}) []listeners.PortRange {
+	ranges := make([]listeners.PortRange, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		ranges[i] = listeners.PortRange{
+			FromPort: m["from_port"].(int),
+			ToPort:   m["to_port"].(int),
+		}
+	}
+	return ranges
+}
+
+func flattenGaListenerPortRanges(portRanges []listeners.PortRange) []map[string]interface{} {
+	ranges := make([]map[string]interface{}, len(portRanges))
+	for i, pr := range portRanges {
+		ranges[i] = map[string]interface{}{
+			"from_port": pr.FromPort,
+			"to_port":   pr.ToPort,
+		}
+	}
+	return ranges
+}
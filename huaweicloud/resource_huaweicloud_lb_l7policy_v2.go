@@ -0,0 +1,257 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REJECT",
+				}, false),
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"redirect_listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	lbID, err := l7policyV2ParentLB(networkingClient, listenerID)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateOpts{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Action:              l7policies.Action(d.Get("action").(string)),
+		ListenerID:          listenerID,
+		RedirectPoolID:      d.Get("redirect_pool_id").(string),
+		RedirectListenerID:  d.Get("redirect_listener_id").(string),
+		AdminStateUp:        &adminStateUp,
+	}
+	if v, ok := d.GetOk("position"); ok {
+		createOpts.Position = int32(v.(int))
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := l7policies.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud LB L7 Policy: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+// l7policyV2ParentLB resolves the load balancer that owns a listener so
+// l7policy/l7rule mutations can be serialized on it by holding the
+// osMutexKV lock keyed by lbV2MutexKey.
+func l7policyV2ParentLB(client *golangsdk.ServiceClient, listenerID string) (string, error) {
+	listener, err := listeners.Get(client, listenerID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Unable to get HuaweiCloud LB listener %s: %s", listenerID, err)
+	}
+	if len(listener.Loadbalancers) == 0 {
+		return "", fmt.Errorf("Unable to determine load balancer for listener %s", listenerID)
+	}
+	return listener.Loadbalancers[0].ID, nil
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	policy, err := l7policies.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("action", policy.Action)
+	d.Set("listener_id", policy.ListenerID)
+	d.Set("position", policy.Position)
+	d.Set("redirect_pool_id", policy.RedirectPoolID)
+	d.Set("redirect_listener_id", policy.RedirectListenerID)
+	d.Set("admin_state_up", policy.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	var updateOpts l7policies.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = d.Get("redirect_pool_id").(string)
+	}
+	if d.HasChange("redirect_listener_id") {
+		updateOpts.RedirectListenerID = d.Get("redirect_listener_id").(string)
+	}
+	// position can be changed in-place; the service re-ranks the policy
+	// among its siblings without requiring a recreate.
+	if d.HasChange("position") {
+		updateOpts.Position = int32(d.Get("position").(int))
+	}
+
+	lbID, err := l7policyV2ParentLB(networkingClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	_, err = l7policies.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud LB L7 Policy: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	lbID, err := l7policyV2ParentLB(networkingClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	err = l7policies.Delete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting HuaweiCloud LB L7 Policy: %s", err)
+		}
+	}
+
+	return waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete))
+}
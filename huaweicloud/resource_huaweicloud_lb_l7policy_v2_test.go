@@ -0,0 +1,178 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func TestAccLBV2L7Policy_basic(t *testing.T) {
+	var policy l7policies.L7Policy
+	policyResourceName := "huaweicloud_lb_l7policy.policy_1"
+	ruleResourceName := "huaweicloud_lb_l7rule.rule_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckULB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLBV2L7PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(policyResourceName, &policy),
+					resource.TestCheckResourceAttr(policyResourceName, "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(ruleResourceName, "type", "PATH"),
+					resource.TestCheckResourceAttr(ruleResourceName, "compare_type", "STARTS_WITH"),
+					resource.TestCheckResourceAttr(ruleResourceName, "value", "/api"),
+				),
+			},
+			{
+				Config: TestAccLBV2L7PolicyConfig_positionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(policyResourceName, &policy),
+					resource.TestCheckResourceAttr(policyResourceName, "position", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_lb_l7policy" {
+			continue
+		}
+
+		_, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 Policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7PolicyExists(n string, policy *l7policies.L7Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+		}
+
+		found, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Policy not found")
+		}
+
+		*policy = *found
+
+		return nil
+	}
+}
+
+var TestAccLBV2L7PolicyConfig_basic = fmt.Sprintf(`
+resource "huaweicloud_lb_loadbalancer" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "huaweicloud_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_default" {
+  name        = "pool_default"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_lb_listener.listener_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_api" {
+  name      = "pool_api"
+  protocol  = "HTTP"
+  lb_method = "ROUND_ROBIN"
+}
+
+resource "huaweicloud_lb_l7policy" "policy_1" {
+  name             = "policy_1"
+  action           = "REDIRECT_TO_POOL"
+  listener_id      = huaweicloud_lb_listener.listener_1.id
+  redirect_pool_id = huaweicloud_lb_pool.pool_api.id
+  position         = 1
+}
+
+resource "huaweicloud_lb_l7rule" "rule_1" {
+  l7policy_id  = huaweicloud_lb_l7policy.policy_1.id
+  type         = "PATH"
+  compare_type = "STARTS_WITH"
+  value        = "/api"
+}
+`, OS_SUBNET_ID)
+
+var TestAccLBV2L7PolicyConfig_positionUpdate = fmt.Sprintf(`
+resource "huaweicloud_lb_loadbalancer" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "huaweicloud_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_default" {
+  name        = "pool_default"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_lb_listener.listener_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_api" {
+  name      = "pool_api"
+  protocol  = "HTTP"
+  lb_method = "ROUND_ROBIN"
+}
+
+resource "huaweicloud_lb_l7policy" "policy_1" {
+  name             = "policy_1"
+  action           = "REDIRECT_TO_POOL"
+  listener_id      = huaweicloud_lb_listener.listener_1.id
+  redirect_pool_id = huaweicloud_lb_pool.pool_api.id
+  position         = 2
+}
+
+resource "huaweicloud_lb_l7rule" "rule_1" {
+  l7policy_id  = huaweicloud_lb_l7policy.policy_1.id
+  type         = "PATH"
+  compare_type = "STARTS_WITH"
+  value        = "/api"
+}
+`, OS_SUBNET_ID)
@@ -0,0 +1,255 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceL7RuleV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"HOST_NAME", "PATH", "COOKIE", "HEADER",
+				}, false),
+			},
+
+			"compare_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"STARTS_WITH", "EQUAL_TO", "REGEX", "CONTAINS",
+				}, false),
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"invert": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// l7ruleV2ParentLB resolves the load balancer that owns an l7rule's
+// l7policy, the same way l7policyV2ParentLB does for l7policy/listener, so
+// l7rule mutations can be serialized on it through lbV2MutexKey too.
+func l7ruleV2ParentLB(client *golangsdk.ServiceClient, l7policyID string) (string, error) {
+	policy, err := l7policies.Get(client, l7policyID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Unable to get HuaweiCloud LB l7policy %s: %s", l7policyID, err)
+	}
+	return l7policyV2ParentLB(client, policy.ListenerID)
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	lbID, err := l7ruleV2ParentLB(networkingClient, l7policyID)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateRuleOpts{
+		RuleType:     l7policies.RuleType(d.Get("type").(string)),
+		CompareType:  l7policies.CompareType(d.Get("compare_type").(string)),
+		Key:          d.Get("key").(string),
+		Value:        d.Get("value").(string),
+		Invert:       d.Get("invert").(bool),
+		AdminStateUp: &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := l7policies.CreateRule(networkingClient, l7policyID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud LB L7 Rule: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	rule, err := l7policies.GetRule(networkingClient, l7policyID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	d.Set("type", rule.RuleType)
+	d.Set("compare_type", rule.CompareType)
+	d.Set("key", rule.Key)
+	d.Set("value", rule.Value)
+	d.Set("invert", rule.Invert)
+	d.Set("admin_state_up", rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	var updateOpts l7policies.UpdateRuleOpts
+
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = l7policies.CompareType(d.Get("compare_type").(string))
+	}
+	if d.HasChange("key") {
+		updateOpts.Key = d.Get("key").(string)
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("invert") {
+		invert := d.Get("invert").(bool)
+		updateOpts.Invert = &invert
+	}
+
+	lbID, err := l7ruleV2ParentLB(networkingClient, l7policyID)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	_, err = l7policies.UpdateRule(networkingClient, l7policyID, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud LB L7 Rule: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	lbID, err := l7ruleV2ParentLB(networkingClient, l7policyID)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	err = l7policies.DeleteRule(networkingClient, l7policyID, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting HuaweiCloud LB L7 Rule: %s", err)
+		}
+	}
+
+	return waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete))
+}
+
+func resourceL7RuleV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid format specified for huaweicloud_lb_l7rule. Must be <l7policy_id>/<l7rule_id>")
+	}
+
+	d.SetId(parts[1])
+	d.Set("l7policy_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,459 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func resourcePoolV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePoolV2Create,
+		Read:   resourcePoolV2Read,
+		Update: resourcePoolV2Update,
+		Delete: resourcePoolV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP", "HTTP", "HTTPS",
+				}, false),
+			},
+
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lb_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP",
+				}, false),
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// persistence is create-only-diff on "type": Huawei's API does not
+			// support switching persistence types in place, but cookie_name can
+			// still be tuned without recreating the pool. There is no timeout
+			// field here: golangsdk's SessionPersistence has nowhere to send
+			// one, and Huawei's pool API has no such knob to read one back from.
+			"persistence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
+							}, false),
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// health_monitor lets a monitor be declared inline instead of via a
+			// separate huaweicloud_lb_monitor resource; the pool resource owns
+			// its lifecycle (created after the pool, deleted before it).
+			"health_monitor": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"PING", "TCP", "HTTP", "HTTPS",
+							}, false),
+						},
+						"delay": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"max_retries": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"url_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"http_method": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"expected_codes": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	lbID, listenerID, err := poolV2ParentLB(networkingClient, d)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := pools.CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Protocol:       pools.Protocol(d.Get("protocol").(string)),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     listenerID,
+		LBMethod:       pools.LBMethod(d.Get("lb_method").(string)),
+		AdminStateUp:   &adminStateUp,
+		Persistence:    expandLBV2PoolPersistence(d.Get("persistence").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	pool, err := pools.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud LB Pool: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(pool.ID)
+
+	if hm := d.Get("health_monitor").([]interface{}); len(hm) > 0 {
+		monitorID, err := createLBV2PoolMonitor(networkingClient, lbID, pool.ID, hm[0].(map[string]interface{}), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+		d.Set("monitor_id", monitorID)
+	}
+
+	return resourcePoolV2Read(d, meta)
+}
+
+func expandLBV2PoolPersistence(raw []interface{}) *pools.SessionPersistence {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	v := raw[0].(map[string]interface{})
+	return &pools.SessionPersistence{
+		Type:       v["type"].(string),
+		CookieName: v["cookie_name"].(string),
+	}
+}
+
+func flattenLBV2PoolPersistence(p *pools.SessionPersistence) []map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"type":        p.Type,
+			"cookie_name": p.CookieName,
+		},
+	}
+}
+
+func createLBV2PoolMonitor(client *golangsdk.ServiceClient, lbID, poolID string, raw map[string]interface{}, timeout time.Duration) (string, error) {
+	if err := waitForLBV2Resource(client, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, timeout); err != nil {
+		return "", err
+	}
+
+	createOpts := monitors.CreateOpts{
+		PoolID:        poolID,
+		Type:          raw["type"].(string),
+		Delay:         raw["delay"].(int),
+		Timeout:       raw["timeout"].(int),
+		MaxRetries:    raw["max_retries"].(int),
+		URLPath:       raw["url_path"].(string),
+		HTTPMethod:    raw["http_method"].(string),
+		ExpectedCodes: raw["expected_codes"].(string),
+	}
+
+	monitor, err := monitors.Create(client, createOpts).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error creating HuaweiCloud LB Pool health_monitor: %s", err)
+	}
+
+	if err := waitForLBV2Resource(client, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, timeout); err != nil {
+		return "", err
+	}
+
+	return monitor.ID, nil
+}
+
+func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	pool, err := pools.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "pool")
+	}
+
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("protocol", pool.Protocol)
+	d.Set("lb_method", pool.LBMethod)
+	d.Set("admin_state_up", pool.AdminStateUp)
+	if len(pool.Listeners) > 0 {
+		d.Set("listener_id", pool.Listeners[0].ID)
+	}
+	if len(pool.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	d.Set("persistence", flattenLBV2PoolPersistence(pool.Persistence))
+
+	if pool.MonitorID != "" {
+		d.Set("monitor_id", pool.MonitorID)
+		monitor, err := monitors.Get(networkingClient, pool.MonitorID).Extract()
+		if err == nil {
+			d.Set("health_monitor", []map[string]interface{}{
+				{
+					"type":           monitor.Type,
+					"delay":          monitor.Delay,
+					"timeout":        monitor.Timeout,
+					"max_retries":    monitor.MaxRetries,
+					"url_path":       monitor.URLPath,
+					"http_method":    monitor.HTTPMethod,
+					"expected_codes": monitor.ExpectedCodes,
+				},
+			})
+		}
+	} else {
+		d.Set("monitor_id", "")
+		d.Set("health_monitor", nil)
+	}
+
+	return nil
+}
+
+func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	lbID, _, err := poolV2ParentLB(networkingClient, d)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	var updateOpts pools.UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+	if d.HasChange("lb_method") {
+		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("persistence.0.cookie_name") {
+		updateOpts.Persistence = expandLBV2PoolPersistence(d.Get("persistence").([]interface{}))
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	_, err = pools.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating HuaweiCloud LB Pool: %s", err)
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	if d.HasChange("health_monitor") {
+		o, n := d.GetChange("health_monitor")
+		oldHM, newHM := o.([]interface{}), n.([]interface{})
+
+		if monitorID := d.Get("monitor_id").(string); len(oldHM) > 0 && monitorID != "" {
+			if err := deleteLBV2PoolMonitor(networkingClient, lbID, monitorID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+		if len(newHM) > 0 {
+			monitorID, err := createLBV2PoolMonitor(networkingClient, lbID, d.Id(), newHM[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			d.Set("monitor_id", monitorID)
+		}
+	}
+
+	return resourcePoolV2Read(d, meta)
+}
+
+func deleteLBV2PoolMonitor(client *golangsdk.ServiceClient, lbID, monitorID string, timeout time.Duration) error {
+	if err := waitForLBV2Resource(client, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, timeout); err != nil {
+		return err
+	}
+
+	if err := monitors.Delete(client, monitorID).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting HuaweiCloud LB Pool health_monitor: %s", err)
+		}
+	}
+
+	return waitForLBV2Resource(client, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, timeout)
+}
+
+func resourcePoolV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud networking client: %s", err)
+	}
+
+	lbID, _, err := poolV2ParentLB(networkingClient, d)
+	if err != nil {
+		return err
+	}
+
+	osMutexKV.Lock(lbV2MutexKey(lbID))
+	defer osMutexKV.Unlock(lbV2MutexKey(lbID))
+
+	if monitorID := d.Get("monitor_id").(string); monitorID != "" {
+		if err := deleteLBV2PoolMonitor(networkingClient, lbID, monitorID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	if err := waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	err = pools.Delete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("Error deleting HuaweiCloud LB Pool: %s", err)
+		}
+	}
+
+	return waitForLBV2Resource(networkingClient, lbV2LoadBalancer, lbID, []string{"ACTIVE"}, d.Timeout(schema.TimeoutDelete))
+}
+
+// poolV2ParentLB resolves the load balancer that owns this pool (directly
+// via loadbalancer_id, or indirectly through listener_id) so callers can
+// serialize mutations on it through lbV2MutexKey.
+func poolV2ParentLB(client *golangsdk.ServiceClient, d *schema.ResourceData) (string, string, error) {
+	if v, ok := d.GetOk("loadbalancer_id"); ok {
+		return v.(string), d.Get("listener_id").(string), nil
+	}
+
+	listenerID := d.Get("listener_id").(string)
+	if listenerID == "" {
+		return "", "", fmt.Errorf("one of loadbalancer_id or listener_id must be set")
+	}
+
+	listener, err := listeners.Get(client, listenerID).Extract()
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to get HuaweiCloud LB listener %s: %s", listenerID, err)
+	}
+	if len(listener.Loadbalancers) == 0 {
+		return "", "", fmt.Errorf("Unable to determine load balancer for listener %s", listenerID)
+	}
+
+	return listener.Loadbalancers[0].ID, listenerID, nil
+}
@@ -37,6 +37,42 @@ func TestAccLBV2Pool_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Pool_persistenceAndMonitor(t *testing.T) {
+	var pool pools.Pool
+	resourceName := "huaweicloud_lb_pool.pool_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckULB(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLBV2PoolConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists(resourceName, &pool),
+				),
+			},
+			{
+				Config: TestAccLBV2PoolConfig_persistenceAndMonitor,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "persistence.0.type", "HTTP_COOKIE"),
+					resource.TestCheckResourceAttr(resourceName, "health_monitor.0.type", "HTTP"),
+					resource.TestCheckResourceAttrSet(resourceName, "monitor_id"),
+				),
+			},
+			{
+				Config: TestAccLBV2PoolConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "persistence.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "health_monitor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2PoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(OS_REGION_NAME)
@@ -117,6 +153,48 @@ resource "huaweicloud_lb_pool" "pool_1" {
 }
 `, OS_SUBNET_ID)
 
+var TestAccLBV2PoolConfig_persistenceAndMonitor = fmt.Sprintf(`
+resource "huaweicloud_lb_loadbalancer" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "huaweicloud_lb_listener" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "huaweicloud_lb_pool" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_lb_listener.listener_1.id
+
+  persistence {
+    type        = "HTTP_COOKIE"
+    cookie_name = "pool_1_cookie"
+  }
+
+  health_monitor {
+    type           = "HTTP"
+    delay          = 5
+    timeout        = 3
+    max_retries    = 3
+    url_path       = "/"
+    http_method    = "GET"
+    expected_codes = "200"
+  }
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, OS_SUBNET_ID)
+
 var TestAccLBV2PoolConfig_update = fmt.Sprintf(`
 resource "huaweicloud_lb_loadbalancer" "loadbalancer_1" {
   name          = "loadbalancer_1"
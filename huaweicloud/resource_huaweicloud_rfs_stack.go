@@ -0,0 +1,258 @@
+package huaweicloud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/rfs/v1/stacks"
+)
+
+// resourceRfsStackV1 manages a Resource Formation Service stack: a
+// provisioned instance of a huaweicloud_rfs_template (or inline HCL/JSON),
+// with its resulting outputs surfaced as computed attributes. last_applied_
+// template_hash is recomputed by every Read from the stack's template_id and
+// the configured template_content and vars; nothing compares it with the
+// previous value, so a changed hash is not reported as drift. RFS itself is
+// the source of truth for the stack's real resources.
+func resourceRfsStackV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRfsStackV1Create,
+		Read:   resourceRfsStackV1Read,
+		Update: resourceRfsStackV1Update,
+		Delete: resourceRfsStackV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"template_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_content"},
+			},
+
+			"template_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_id"},
+			},
+
+			"vars": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_applied_template_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRfsStackV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	epsID := d.Get("enterprise_project_id").(string)
+	if epsID == "" {
+		epsID = config.EnterpriseProjectID
+	}
+
+	createOpts := stacks.CreateOpts{
+		Name:                d.Get("name").(string),
+		TemplateID:          d.Get("template_id").(string),
+		TemplateContent:     d.Get("template_content").(string),
+		Vars:                expandRfsStackVars(d.Get("vars").(map[string]interface{})),
+		EnterpriseProjectID: epsID,
+	}
+
+	stack, err := stacks.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS stack: %s", err)
+	}
+
+	d.SetId(stack.ID)
+
+	if err := waitForRfsStackEvent(client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceRfsStackV1Read(d, meta)
+}
+
+func resourceRfsStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	stack, err := stacks.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "RFS stack")
+	}
+
+	d.Set("region", region)
+	d.Set("name", stack.Name)
+	d.Set("template_id", stack.TemplateID)
+	d.Set("status", stack.Status)
+	d.Set("outputs", stack.Outputs)
+	d.Set("enterprise_project_id", stack.EnterpriseProjectID)
+	d.Set("last_applied_template_hash", rfsStackTemplateHash(d))
+
+	return nil
+}
+
+func resourceRfsStackV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	if d.HasChanges("template_id", "template_content", "vars") {
+		updateOpts := stacks.UpdateOpts{
+			TemplateID:      d.Get("template_id").(string),
+			TemplateContent: d.Get("template_content").(string),
+			Vars:            expandRfsStackVars(d.Get("vars").(map[string]interface{})),
+		}
+		if _, err := stacks.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud RFS stack: %s", err)
+		}
+
+		if err := waitForRfsStackEvent(client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceRfsStackV1Read(d, meta)
+}
+
+func resourceRfsStackV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	if err := stacks.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud RFS stack: %s", err)
+	}
+
+	return waitForRfsStackEvent(client, d, d.Timeout(schema.TimeoutDelete))
+}
+
+// waitForRfsStackEvent polls ShowStackEvents until the stack's most recent
+// event reaches a terminal status, the same create/update/delete waiter
+// shape used by cloudPipelineRunOnApply for pipeline runs.
+func waitForRfsStackEvent(client *golangsdk.ServiceClient, d *schema.ResourceData, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"IN_PROGRESS"},
+		Target:     []string{"COMPLETE", "DELETE_COMPLETE"},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			events, err := stacks.ShowStackEvents(client, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if len(events) == 0 {
+				return nil, "", fmt.Errorf("HuaweiCloud RFS stack %s has no events yet", d.Id())
+			}
+			latest := events[len(events)-1]
+			if latest.Status == "FAILED" {
+				return nil, "", fmt.Errorf("HuaweiCloud RFS stack %s reached FAILED status", d.Id())
+			}
+			return latest, latest.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud RFS stack %s to reach a terminal state: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRfsStackVars(raw map[string]interface{}) map[string]string {
+	vars := make(map[string]string, len(raw))
+	for k, v := range raw {
+		vars[k] = v.(string)
+	}
+	return vars
+}
+
+// rfsStackTemplateHash hashes the stack's current template_id/
+// template_content/vars, with vars taken in key order so equal inputs give
+// equal hashes. Read stores the result in last_applied_template_hash without
+// comparing it to the value already in state.
+func rfsStackTemplateHash(d *schema.ResourceData) string {
+	h := sha256.New()
+	h.Write([]byte(d.Get("template_id").(string)))
+	h.Write([]byte(d.Get("template_content").(string)))
+	vars := d.Get("vars").(map[string]interface{})
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte(vars[k].(string)))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
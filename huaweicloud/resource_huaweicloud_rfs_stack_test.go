@@ -0,0 +1,43 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// TestAccRfsStack_failed provisions a stack from an inline template that RFS
+// is guaranteed to reject at apply time, so waitForRfsStackEvent's Refresh
+// func must observe the stack's terminal FAILED event and return an error
+// instead of letting StateChangeConf.Target treat FAILED as success.
+func TestAccRfsStack_failed(t *testing.T) {
+	rName := fmt.Sprintf("acpttest-rfs-stack-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRfsStack_failed(rName),
+				ExpectError: regexp.MustCompile(`reached FAILED status`),
+			},
+		},
+	})
+}
+
+func testAccRfsStack_failed(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_rfs_stack" "stack_1" {
+  name = "%s"
+
+  template_content = <<EOT
+resource "not_a_real_provider_resource" "broken" {
+  name = "broken"
+}
+EOT
+}
+`, rName)
+}
@@ -0,0 +1,145 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/rfs/v1/templates"
+)
+
+// resourceRfsTemplateV1 manages a Resource Formation Service template: a
+// packaged main.tf/variables.tf bundle (or inline HCL/JSON) that one or
+// more huaweicloud_rfs_stack resources can be created from.
+func resourceRfsTemplateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRfsTemplateV1Create,
+		Read:   resourceRfsTemplateV1Read,
+		Update: resourceRfsTemplateV1Update,
+		Delete: resourceRfsTemplateV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "hcl",
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRfsTemplateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	createOpts := templates.CreateOpts{
+		Name:        d.Get("name").(string),
+		Content:     d.Get("content").(string),
+		ContentType: d.Get("content_type").(string),
+		Description: d.Get("description").(string),
+	}
+
+	template, err := templates.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS template: %s", err)
+	}
+
+	d.SetId(template.ID)
+
+	return resourceRfsTemplateV1Read(d, meta)
+}
+
+func resourceRfsTemplateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	template, err := templates.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "RFS template")
+	}
+
+	d.Set("region", region)
+	d.Set("name", template.Name)
+	d.Set("content", template.Content)
+	d.Set("content_type", template.ContentType)
+	d.Set("description", template.Description)
+	d.Set("version_id", template.VersionID)
+
+	return nil
+}
+
+func resourceRfsTemplateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	if d.HasChanges("content", "content_type", "description") {
+		updateOpts := templates.UpdateOpts{
+			Content:     d.Get("content").(string),
+			ContentType: d.Get("content_type").(string),
+			Description: d.Get("description").(string),
+		}
+		if _, err := templates.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud RFS template: %s", err)
+		}
+	}
+
+	return resourceRfsTemplateV1Read(d, meta)
+}
+
+func resourceRfsTemplateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.RfsV1Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud RFS client: %s", err)
+	}
+
+	if err := templates.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud RFS template: %s", err)
+	}
+
+	return nil
+}
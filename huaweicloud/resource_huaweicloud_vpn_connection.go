@@ -0,0 +1,417 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/connections"
+)
+
+// resourceVpnConnectionV5 manages an IPsec tunnel between a
+// huaweicloud_vpn_gateway and a huaweicloud_vpn_customer_gateway. It
+// supports both policy-based tunnels (peer_subnets) and route-based
+// tunnels (tunnel_local_address/tunnel_peer_address, optionally with BGP).
+func resourceVpnConnectionV5() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnConnectionV5Create,
+		Read:   resourceVpnConnectionV5Read,
+		Update: resourceVpnConnectionV5Update,
+		Delete: resourceVpnConnectionV5Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"gateway_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"customer_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"vpn_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "policy",
+				ValidateFunc: validation.StringInSlice([]string{
+					"policy", "static-route", "bgp",
+				}, false),
+			},
+
+			"peer_subnets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tunnel_local_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tunnel_peer_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"psk": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"ikepolicy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"ike_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"dh_group": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"lifetime_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"local_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"peer_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"dpd_interval": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"dpd_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ipsecpolicy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"pfs": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"transform_protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"encapsulation_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"lifetime_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnConnectionV5Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	createOpts := connections.CreateOpts{
+		Name:               d.Get("name").(string),
+		GatewayID:          d.Get("gateway_id").(string),
+		GatewayIP:          d.Get("gateway_ip").(string),
+		CustomerGatewayID:  d.Get("customer_gateway_id").(string),
+		VpnType:            d.Get("vpn_type").(string),
+		PeerSubnets:        expandVpnConnectionStringList(d.Get("peer_subnets").([]interface{})),
+		TunnelLocalAddress: d.Get("tunnel_local_address").(string),
+		TunnelPeerAddress:  d.Get("tunnel_peer_address").(string),
+		PSK:                d.Get("psk").(string),
+		IKEPolicy:          expandVpnConnectionIKEPolicy(d.Get("ikepolicy").([]interface{})),
+		IPsecPolicy:        expandVpnConnectionIPsecPolicy(d.Get("ipsecpolicy").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	conn, err := connections.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN connection: %s", err)
+	}
+
+	d.SetId(conn.ID)
+
+	if err := waitForVpnConnectionActive(client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceVpnConnectionV5Read(d, meta)
+}
+
+func resourceVpnConnectionV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	conn, err := connections.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN connection")
+	}
+
+	d.Set("region", region)
+	d.Set("name", conn.Name)
+	d.Set("gateway_id", conn.GatewayID)
+	d.Set("gateway_ip", conn.GatewayIP)
+	d.Set("customer_gateway_id", conn.CustomerGatewayID)
+	d.Set("vpn_type", conn.VpnType)
+	d.Set("peer_subnets", conn.PeerSubnets)
+	d.Set("tunnel_local_address", conn.TunnelLocalAddress)
+	d.Set("tunnel_peer_address", conn.TunnelPeerAddress)
+	d.Set("status", conn.Status)
+	d.Set("ikepolicy", flattenVpnConnectionIKEPolicy(conn.IKEPolicy))
+	d.Set("ipsecpolicy", flattenVpnConnectionIPsecPolicy(conn.IPsecPolicy))
+
+	return nil
+}
+
+func resourceVpnConnectionV5Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if d.HasChanges("name", "peer_subnets") {
+		updateOpts := connections.UpdateOpts{
+			Name:        d.Get("name").(string),
+			PeerSubnets: expandVpnConnectionStringList(d.Get("peer_subnets").([]interface{})),
+		}
+		if _, err := connections.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud VPN connection: %s", err)
+		}
+		if err := waitForVpnConnectionActive(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceVpnConnectionV5Read(d, meta)
+}
+
+func resourceVpnConnectionV5Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if err := connections.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPN connection: %s", err)
+	}
+
+	return nil
+}
+
+func expandVpnConnectionStringList(raw []interface{}) []string {
+	list := make([]string, len(raw))
+	for i, v := range raw {
+		list[i] = v.(string)
+	}
+	return list
+}
+
+func expandVpnConnectionIKEPolicy(raw []interface{}) *connections.IKEPolicy {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &connections.IKEPolicy{
+		AuthenticationAlgorithm: m["authentication_algorithm"].(string),
+		EncryptionAlgorithm:     m["encryption_algorithm"].(string),
+		IKEVersion:              m["ike_version"].(string),
+		DHGroup:                 m["dh_group"].(string),
+		LifetimeSeconds:         m["lifetime_seconds"].(int),
+		LocalID:                 m["local_id"].(string),
+		PeerID:                  m["peer_id"].(string),
+		DPDInterval:             m["dpd_interval"].(int),
+		DPDTimeout:              m["dpd_timeout"].(int),
+	}
+}
+
+func flattenVpnConnectionIKEPolicy(p *connections.IKEPolicy) []map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"authentication_algorithm": p.AuthenticationAlgorithm,
+			"encryption_algorithm":     p.EncryptionAlgorithm,
+			"ike_version":              p.IKEVersion,
+			"dh_group":                 p.DHGroup,
+			"lifetime_seconds":         p.LifetimeSeconds,
+			"local_id":                 p.LocalID,
+			"peer_id":                  p.PeerID,
+			"dpd_interval":             p.DPDInterval,
+			"dpd_timeout":              p.DPDTimeout,
+		},
+	}
+}
+
+func expandVpnConnectionIPsecPolicy(raw []interface{}) *connections.IPsecPolicy {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &connections.IPsecPolicy{
+		AuthenticationAlgorithm: m["authentication_algorithm"].(string),
+		EncryptionAlgorithm:     m["encryption_algorithm"].(string),
+		PFS:                     m["pfs"].(string),
+		TransformProtocol:       m["transform_protocol"].(string),
+		EncapsulationMode:       m["encapsulation_mode"].(string),
+		LifetimeSeconds:         m["lifetime_seconds"].(int),
+	}
+}
+
+func flattenVpnConnectionIPsecPolicy(p *connections.IPsecPolicy) []map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"authentication_algorithm": p.AuthenticationAlgorithm,
+			"encryption_algorithm":     p.EncryptionAlgorithm,
+			"pfs":                      p.PFS,
+			"transform_protocol":       p.TransformProtocol,
+			"encapsulation_mode":       p.EncapsulationMode,
+			"lifetime_seconds":         p.LifetimeSeconds,
+		},
+	}
+}
+
+func waitForVpnConnectionActive(client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    vpnConnectionStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPN connection %s to become ACTIVE: %s", id, err)
+	}
+	return nil
+}
+
+func vpnConnectionStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		conn, err := connections.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return conn, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return conn, conn.Status, nil
+	}
+}
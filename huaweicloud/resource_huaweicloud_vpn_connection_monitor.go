@@ -0,0 +1,163 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/connectionmonitors"
+)
+
+// resourceVpnConnectionMonitorV5 manages the NQA-based health check that
+// flips a huaweicloud_vpn_connection's tunnel between active and standby in
+// an active-standby gateway.
+func resourceVpnConnectionMonitorV5() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnConnectionMonitorV5Create,
+		Read:   resourceVpnConnectionMonitorV5Read,
+		Update: resourceVpnConnectionMonitorV5Update,
+		Delete: resourceVpnConnectionMonitorV5Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"destination_ip": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"interval": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3000,
+			},
+
+			"retry_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnConnectionMonitorV5Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	createOpts := connectionmonitors.CreateOpts{
+		ConnectionID:  d.Get("connection_id").(string),
+		SourceIP:      d.Get("source_ip").(string),
+		DestinationIP: d.Get("destination_ip").(string),
+		Interval:      d.Get("interval").(int),
+		Timeout:       d.Get("timeout").(int),
+		RetryCount:    d.Get("retry_count").(int),
+	}
+
+	monitor, err := connectionmonitors.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN connection monitor: %s", err)
+	}
+
+	d.SetId(monitor.ID)
+
+	return resourceVpnConnectionMonitorV5Read(d, meta)
+}
+
+func resourceVpnConnectionMonitorV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	monitor, err := connectionmonitors.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN connection monitor")
+	}
+
+	d.Set("region", region)
+	d.Set("connection_id", monitor.ConnectionID)
+	d.Set("source_ip", monitor.SourceIP)
+	d.Set("destination_ip", monitor.DestinationIP)
+	d.Set("interval", monitor.Interval)
+	d.Set("timeout", monitor.Timeout)
+	d.Set("retry_count", monitor.RetryCount)
+	d.Set("status", monitor.Status)
+
+	return nil
+}
+
+func resourceVpnConnectionMonitorV5Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if d.HasChanges("interval", "timeout", "retry_count") {
+		updateOpts := connectionmonitors.UpdateOpts{
+			Interval:   d.Get("interval").(int),
+			Timeout:    d.Get("timeout").(int),
+			RetryCount: d.Get("retry_count").(int),
+		}
+		if _, err := connectionmonitors.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud VPN connection monitor: %s", err)
+		}
+	}
+
+	return resourceVpnConnectionMonitorV5Read(d, meta)
+}
+
+func resourceVpnConnectionMonitorV5Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if err := connectionmonitors.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPN connection monitor: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/customergateways"
+)
+
+// resourceVpnCustomerGatewayV5 describes the peer device a VPN connection
+// terminates on. Authentication is either identifier-based (PSK, optionally
+// behind NAT) or certificate-based; the two are mutually exclusive.
+func resourceVpnCustomerGatewayV5() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnCustomerGatewayV5Create,
+		Read:   resourceVpnCustomerGatewayV5Read,
+		Update: resourceVpnCustomerGatewayV5Update,
+		Delete: resourceVpnCustomerGatewayV5Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"id_value": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"certificate_content"},
+			},
+
+			"id_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ip",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ip", "fqdn",
+				}, false),
+			},
+
+			"certificate_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"id_value"},
+			},
+		},
+	}
+}
+
+func resourceVpnCustomerGatewayV5Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	createOpts := customergateways.CreateOpts{
+		Name:               d.Get("name").(string),
+		IPAddress:          d.Get("ip_address").(string),
+		IDValue:            d.Get("id_value").(string),
+		IDType:             d.Get("id_type").(string),
+		CertificateContent: d.Get("certificate_content").(string),
+	}
+
+	cgw, err := customergateways.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN customer gateway: %s", err)
+	}
+
+	d.SetId(cgw.ID)
+
+	return resourceVpnCustomerGatewayV5Read(d, meta)
+}
+
+func resourceVpnCustomerGatewayV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	cgw, err := customergateways.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN customer gateway")
+	}
+
+	d.Set("region", region)
+	d.Set("name", cgw.Name)
+	d.Set("ip_address", cgw.IPAddress)
+	d.Set("id_value", cgw.IDValue)
+	d.Set("id_type", cgw.IDType)
+
+	return nil
+}
+
+func resourceVpnCustomerGatewayV5Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := customergateways.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if _, err := customergateways.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud VPN customer gateway: %s", err)
+		}
+	}
+
+	return resourceVpnCustomerGatewayV5Read(d, meta)
+}
+
+func resourceVpnCustomerGatewayV5Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if err := customergateways.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPN customer gateway: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package huaweicloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/gateways"
+)
+
+// resourceVpnGatewayV5 manages the native HuaweiCloud VPN gateway, as
+// opposed to the legacy Neutron-style huaweicloud_vpnaas_service.
+func resourceVpnGatewayV5() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnGatewayV5Create,
+		Read:   resourceVpnGatewayV5Read,
+		Update: resourceVpnGatewayV5Update,
+		Delete: resourceVpnGatewayV5Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "vpc",
+				ValidateFunc: validation.StringInSlice([]string{
+					"vpc", "er",
+				}, false),
+			},
+
+			"ha_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "active-active",
+				ValidateFunc: validation.StringInSlice([]string{
+					"active-active", "active-standby",
+				}, false),
+			},
+
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  64512,
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"eip": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnGatewayV5Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	epsID := d.Get("enterprise_project_id").(string)
+	if epsID == "" {
+		epsID = config.EnterpriseProjectID
+	}
+
+	createOpts := gateways.CreateOpts{
+		Name:                d.Get("name").(string),
+		VpcID:               d.Get("vpc_id").(string),
+		AttachmentType:      d.Get("attachment_type").(string),
+		HAMode:              d.Get("ha_mode").(string),
+		BgpAsn:              d.Get("bgp_asn").(int),
+		AvailabilityZones:   expandVpnGatewayAZs(d.Get("availability_zones").([]interface{})),
+		Eips:                expandVpnGatewayEips(d.Get("eip").([]interface{})),
+		EnterpriseProjectID: epsID,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	gateway, err := gateways.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN gateway: %s", err)
+	}
+
+	d.SetId(gateway.ID)
+
+	if err := waitForVpnGatewayActive(client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceVpnGatewayV5Read(d, meta)
+}
+
+func resourceVpnGatewayV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	gateway, err := gateways.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN gateway")
+	}
+
+	d.Set("region", region)
+	d.Set("name", gateway.Name)
+	d.Set("vpc_id", gateway.VpcID)
+	d.Set("attachment_type", gateway.AttachmentType)
+	d.Set("ha_mode", gateway.HAMode)
+	d.Set("bgp_asn", gateway.BgpAsn)
+	d.Set("status", gateway.Status)
+	d.Set("availability_zones", gateway.AvailabilityZones)
+	d.Set("eip", flattenVpnGatewayEips(gateway.Eips))
+	d.Set("enterprise_project_id", gateway.EnterpriseProjectID)
+
+	return nil
+}
+
+func resourceVpnGatewayV5Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := gateways.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if _, err := gateways.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud VPN gateway: %s", err)
+		}
+		if err := waitForVpnGatewayActive(client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceVpnGatewayV5Read(d, meta)
+}
+
+func resourceVpnGatewayV5Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if err := gateways.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPN gateway: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    vpnGatewayStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	return err
+}
+
+func expandVpnGatewayAZs(raw []interface{}) []string {
+	azs := make([]string, len(raw))
+	for i, v := range raw {
+		azs[i] = v.(string)
+	}
+	return azs
+}
+
+func expandVpnGatewayEips(raw []interface{}) []gateways.Eip {
+	eips := make([]gateways.Eip, len(raw))
+	for i, v := range raw {
+		m := v.(map[string]interface{})
+		eips[i] = gateways.Eip{
+			ID:   m["id"].(string),
+			Type: m["type"].(string),
+		}
+	}
+	return eips
+}
+
+func flattenVpnGatewayEips(eips []gateways.Eip) []map[string]interface{} {
+	raw := make([]map[string]interface{}, len(eips))
+	for i, e := range eips {
+		raw[i] = map[string]interface{}{
+			"id":      e.ID,
+			"type":    e.Type,
+			"address": e.Address,
+		}
+	}
+	return raw
+}
+
+func waitForVpnGatewayActive(client *golangsdk.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATING", "UPDATING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    vpnGatewayStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for HuaweiCloud VPN gateway %s to become ACTIVE: %s", id, err)
+	}
+	return nil
+}
+
+func vpnGatewayStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gateway, err := gateways.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return gateway, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return gateway, gateway.Status, nil
+	}
+}
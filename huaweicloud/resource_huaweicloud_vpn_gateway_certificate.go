@@ -0,0 +1,146 @@
+package huaweicloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/vpn/v5/gatewaycertificates"
+)
+
+// resourceVpnGatewayCertificateV5 manages the certificate a VPN gateway
+// presents for certificate-based IKE authentication.
+func resourceVpnGatewayCertificateV5() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVpnGatewayCertificateV5Create,
+		Read:   resourceVpnGatewayCertificateV5Read,
+		Update: resourceVpnGatewayCertificateV5Update,
+		Delete: resourceVpnGatewayCertificateV5Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"certificate_content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"private_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"organization": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpnGatewayCertificateV5Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	gatewayID := d.Get("gateway_id").(string)
+	createOpts := gatewaycertificates.CreateOpts{
+		CertificateContent: d.Get("certificate_content").(string),
+		PrivateKey:         d.Get("private_key").(string),
+	}
+
+	cert, err := gatewaycertificates.Create(client, gatewayID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN gateway certificate: %s", err)
+	}
+
+	d.SetId(cert.ID)
+
+	return resourceVpnGatewayCertificateV5Read(d, meta)
+}
+
+func resourceVpnGatewayCertificateV5Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	cert, err := gatewaycertificates.Get(client, d.Get("gateway_id").(string), d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN gateway certificate")
+	}
+
+	d.Set("region", region)
+	d.Set("name", cert.Name)
+	d.Set("organization", cert.Organization)
+	d.Set("expire_time", cert.ExpireTime)
+
+	return nil
+}
+
+func resourceVpnGatewayCertificateV5Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if d.HasChange("certificate_content") {
+		updateOpts := gatewaycertificates.UpdateOpts{
+			CertificateContent: d.Get("certificate_content").(string),
+		}
+		if _, err := gatewaycertificates.Update(client, d.Get("gateway_id").(string), d.Id(), updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error updating HuaweiCloud VPN gateway certificate: %s", err)
+		}
+	}
+
+	return resourceVpnGatewayCertificateV5Read(d, meta)
+}
+
+func resourceVpnGatewayCertificateV5Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	client, err := config.VpnV5Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating HuaweiCloud VPN client: %s", err)
+	}
+
+	if err := gatewaycertificates.Delete(client, d.Get("gateway_id").(string), d.Id()).ExtractErr(); err != nil {
+		return fmt.Errorf("Error deleting HuaweiCloud VPN gateway certificate: %s", err)
+	}
+
+	return nil
+}